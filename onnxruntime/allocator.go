@@ -1,8 +1,10 @@
 package onnxruntime
 
 import (
+	"fmt"
 	"unsafe"
 
+	"github.com/shota3506/onnxruntime-purego/internal/cstrings"
 	"github.com/shota3506/onnxruntime-purego/onnxruntime/internal/api"
 )
 
@@ -21,14 +23,43 @@ func (a *allocator) free(ptr unsafe.Pointer) {
 	a.runtime.apiFuncs.AllocatorFree(a.ptr, ptr)
 }
 
-// memoryInfo represents ONNX Runtime memory information (internal use only)
-type memoryInfo struct {
+// takeString converts a C string allocated by the allocator to a Go string
+// and frees the C string (internal use)
+func (a *allocator) takeString(ptr *byte) string {
+	if ptr == nil {
+		return ""
+	}
+	s := cstrings.CStringToString(ptr)
+	a.free(unsafe.Pointer(ptr))
+	return s
+}
+
+// MemoryInfo describes where memory is located, such as CPU memory or the
+// memory of a device used by an execution provider.
+type MemoryInfo struct {
 	ptr     api.OrtMemoryInfo
 	runtime *Runtime
 }
 
-// release releases the memory info (internal use)
-func (mi *memoryInfo) release() {
+// NewMemoryInfo creates memory info for the default memory of a device.
+// name identifies the allocator of the device, such as "Cpu" or "Cuda", and
+// deviceID selects the device. The memory info must be closed when no longer needed.
+func (r *Runtime) NewMemoryInfo(name string, deviceID int) (*MemoryInfo, error) {
+	nameBytes := append([]byte(name), 0)
+	var memInfoPtr api.OrtMemoryInfo
+	status := r.apiFuncs.CreateMemoryInfo(&nameBytes[0], allocatorTypeDevice, int32(deviceID), memTypeDefault, &memInfoPtr)
+	if err := r.statusError(status, "CreateMemoryInfo"); err != nil {
+		return nil, fmt.Errorf("failed to create memory info: %w", err)
+	}
+
+	return &MemoryInfo{
+		ptr:     memInfoPtr,
+		runtime: r,
+	}, nil
+}
+
+// Close releases the memory info. It is safe to call Close multiple times.
+func (mi *MemoryInfo) Close() {
 	if mi.ptr != 0 && mi.runtime != nil && mi.runtime.apiFuncs != nil {
 		mi.runtime.apiFuncs.ReleaseMemoryInfo(mi.ptr)
 		mi.ptr = 0
@@ -2,22 +2,27 @@ package onnxruntime
 
 import (
 	"bytes"
+	"context"
 	"os"
 	"testing"
 )
 
-func BenchmarkSessionRun(b *testing.B) {
+// newBenchmarkSession creates a session on the test model and an input tensor for it.
+// Both are closed when the benchmark finishes.
+func newBenchmarkSession(b *testing.B) (*Session, *Value) {
+	b.Helper()
+
 	runtime, err := NewRuntime(libraryPath, 23)
 	if err != nil {
 		b.Fatalf("Failed to create runtime: %v", err)
 	}
-	defer runtime.Close()
+	b.Cleanup(func() { runtime.Close() })
 
 	env, err := runtime.NewEnv("test", LoggingLevelWarning)
 	if err != nil {
 		b.Fatalf("Failed to create environment: %v", err)
 	}
-	defer env.Close()
+	b.Cleanup(func() { env.Close() })
 
 	modelData, err := os.ReadFile(testModelPath())
 	if err != nil {
@@ -28,7 +33,7 @@ func BenchmarkSessionRun(b *testing.B) {
 	if err != nil {
 		b.Fatalf("Failed to create session: %v", err)
 	}
-	defer session.Close()
+	b.Cleanup(func() { session.Close() })
 
 	inputData := []float32{1.0, 2.0, 3.0, 4.0, 5.0, 6.0, 7.0, 8.0, 9.0, 10.0}
 	inputShape := []int64{1, 10}
@@ -37,12 +42,19 @@ func BenchmarkSessionRun(b *testing.B) {
 	if err != nil {
 		b.Fatalf("Failed to create input tensor: %v", err)
 	}
-	defer inputTensor.Close()
+	b.Cleanup(func() { inputTensor.Close() })
+
+	return session, inputTensor
+}
+
+func BenchmarkSessionRun(b *testing.B) {
+	session, inputTensor := newBenchmarkSession(b)
 
 	inputs := map[string]*Value{
 		"input": inputTensor,
 	}
 
+	b.ReportAllocs()
 	for b.Loop() {
 		outputs, err := session.Run(b.Context(), inputs)
 		if err != nil {
@@ -53,3 +65,83 @@ func BenchmarkSessionRun(b *testing.B) {
 		}
 	}
 }
+
+func BenchmarkPreparedRun(b *testing.B) {
+	session, inputTensor := newBenchmarkSession(b)
+
+	prepared, err := session.Prepare([]string{"input"}, []string{"logits"})
+	if err != nil {
+		b.Fatalf("Failed to prepare run: %v", err)
+	}
+	defer prepared.Close()
+
+	inputs := []*Value{inputTensor}
+
+	benchmarks := []struct {
+		name string
+		ctx  func(b *testing.B) context.Context
+	}{
+		{"Background", func(*testing.B) context.Context { return context.Background() }},
+		{"Cancellable", func(b *testing.B) context.Context { return b.Context() }},
+	}
+	for _, bm := range benchmarks {
+		b.Run(bm.name, func(b *testing.B) {
+			ctx := bm.ctx(b)
+
+			b.ReportAllocs()
+			for b.Loop() {
+				outputs, err := prepared.Run(ctx, inputs)
+				if err != nil {
+					b.Fatalf("Failed to run inference: %v", err)
+				}
+				for _, output := range outputs {
+					output.Close()
+				}
+			}
+		})
+	}
+}
+
+func BenchmarkGetTensorData(b *testing.B) {
+	runtime, err := NewRuntime(libraryPath, 23)
+	if err != nil {
+		b.Fatalf("Failed to create runtime: %v", err)
+	}
+	defer runtime.Close()
+
+	tensor, err := NewTensorValue(runtime, make([]float32, 256*1024), []int64{256, 1024})
+	if err != nil {
+		b.Fatalf("Failed to create tensor: %v", err)
+	}
+	defer tensor.Close()
+
+	b.ReportAllocs()
+	for b.Loop() {
+		if _, _, err := GetTensorData[float32](tensor); err != nil {
+			b.Fatalf("Failed to get tensor data: %v", err)
+		}
+	}
+}
+
+func BenchmarkTensorView(b *testing.B) {
+	runtime, err := NewRuntime(libraryPath, 23)
+	if err != nil {
+		b.Fatalf("Failed to create runtime: %v", err)
+	}
+	defer runtime.Close()
+
+	tensor, err := NewTensorValue(runtime, make([]float32, 256*1024), []int64{256, 1024})
+	if err != nil {
+		b.Fatalf("Failed to create tensor: %v", err)
+	}
+	defer tensor.Close()
+
+	b.ReportAllocs()
+	for b.Loop() {
+		view, err := TensorView[float32](tensor)
+		if err != nil {
+			b.Fatalf("Failed to create tensor view: %v", err)
+		}
+		_ = view.Data()
+	}
+}
@@ -2,6 +2,7 @@ package onnxruntime
 
 import (
 	"fmt"
+	"log/slog"
 
 	"github.com/shota3506/onnxruntime-purego/onnxruntime/internal/api"
 )
@@ -11,6 +12,9 @@ import (
 type Env struct {
 	ptr     api.OrtEnv
 	runtime *Runtime
+
+	// loggerID identifies the logger registered by NewEnvWithLogger, or 0
+	loggerID uintptr
 }
 
 // NewEnv creates a new ONNX Runtime environment with the specified logging level and identifier.
@@ -20,9 +24,10 @@ func (r *Runtime) NewEnv(logID string, logLevel LoggingLevel) (*Env, error) {
 	var envPtr api.OrtEnv
 
 	status := r.apiFuncs.CreateEnv(logLevel, &logIDBytes[0], &envPtr)
-	if err := r.statusError(status); err != nil {
+	if err := r.statusError(status, "CreateEnv"); err != nil {
 		return nil, fmt.Errorf("failed to create environment: %w", err)
 	}
+	acquireEnv()
 
 	return &Env{
 		ptr:     envPtr,
@@ -30,10 +35,44 @@ func (r *Runtime) NewEnv(logID string, logLevel LoggingLevel) (*Env, error) {
 	}, nil
 }
 
+// NewEnvWithLogger creates a new ONNX Runtime environment that sends its log
+// messages to logger instead of stderr. Messages below logLevel are discarded by
+// ONNX Runtime before reaching the logger. Each message is logged at the slog
+// level matching its severity, with the "category", "log_id" and "code_location"
+// attributes. If logger is nil, slog.Default() is used.
+//
+// ONNX Runtime keeps a single environment per process, so the logger only takes
+// effect if no other environment exists when NewEnvWithLogger is called. It then
+// receives the messages of all environments until every one of them is closed.
+func (r *Runtime) NewEnvWithLogger(logID string, logLevel LoggingLevel, logger *slog.Logger) (*Env, error) {
+	if logger == nil {
+		logger = slog.Default()
+	}
+
+	logIDBytes := append([]byte(logID), 0)
+	var envPtr api.OrtEnv
+
+	loggerID := registerLogger(logger)
+	status := r.apiFuncs.CreateEnvWithCustomLogger(getLoggingCallback(), loggerID, logLevel, &logIDBytes[0], &envPtr)
+	if err := r.statusError(status, "CreateEnvWithCustomLogger"); err != nil {
+		unregisterLogger(loggerID)
+		return nil, fmt.Errorf("failed to create environment: %w", err)
+	}
+	acquireEnv()
+
+	return &Env{
+		ptr:      envPtr,
+		runtime:  r,
+		loggerID: loggerID,
+	}, nil
+}
+
 // Close releases the environment and frees associated resources.
 func (e *Env) Close() {
 	if e.ptr != 0 && e.runtime != nil && e.runtime.apiFuncs != nil {
 		e.runtime.apiFuncs.ReleaseEnv(e.ptr)
 		e.ptr = 0
+		releaseEnv(e.loggerID)
+		e.loggerID = 0
 	}
 }
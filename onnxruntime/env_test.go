@@ -1,6 +1,12 @@
 package onnxruntime
 
 import (
+	"bytes"
+	"encoding/json"
+	"log/slog"
+	"slices"
+	"strings"
+	"sync"
 	"testing"
 )
 
@@ -46,3 +52,123 @@ func TestEnvClose(t *testing.T) {
 	// Second close should not panic
 	env.Close()
 }
+
+// syncBuffer is a bytes.Buffer safe for concurrent writes from ONNX Runtime threads.
+type syncBuffer struct {
+	mu  sync.Mutex
+	buf bytes.Buffer
+}
+
+func (b *syncBuffer) Write(p []byte) (int, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.buf.Write(p)
+}
+
+func (b *syncBuffer) String() string {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.buf.String()
+}
+
+func TestNewEnvWithLogger(t *testing.T) {
+	runtime := newTestRuntime(t)
+
+	var buf syncBuffer
+	logger := slog.New(slog.NewJSONHandler(&buf, &slog.HandlerOptions{Level: slog.LevelDebug - 4}))
+
+	env, err := runtime.NewEnvWithLogger("test-logger", LoggingLevelVerbose, logger)
+	if err != nil {
+		t.Fatalf("Failed to create environment: %v", err)
+	}
+
+	// Creating a session emits verbose log messages
+	session, err := runtime.NewSession(env, testModelPath(), nil)
+	if err != nil {
+		t.Fatalf("Failed to create session: %v", err)
+	}
+	session.Close()
+	env.Close()
+
+	output := strings.TrimSpace(buf.String())
+	if output == "" {
+		t.Fatal("Expected log messages to be written to the logger")
+	}
+
+	validLevels := []any{"DEBUG-4", "INFO", "WARN", "ERROR", "ERROR+4"}
+	levels := make(map[any]int)
+
+	for line := range strings.SplitSeq(output, "\n") {
+		var record map[string]any
+		if err := json.Unmarshal([]byte(line), &record); err != nil {
+			t.Fatalf("Failed to parse log record %q: %v", line, err)
+		}
+		for _, key := range []string{"msg", "category", "log_id", "code_location"} {
+			if _, ok := record[key]; !ok {
+				t.Errorf("Log record %q is missing %q", line, key)
+			}
+		}
+		levels[record["level"]]++
+	}
+
+	// Session initialization is logged at the info level
+	if levels["INFO"] == 0 {
+		t.Errorf("Expected an INFO log record, got levels %v", levels)
+	}
+	for level := range levels {
+		if !slices.Contains(validLevels, level) {
+			t.Errorf("Unexpected log level %v", level)
+		}
+	}
+}
+
+func TestNewEnvWithLoggerSharedEnv(t *testing.T) {
+	runtime := newTestRuntime(t)
+
+	var buf syncBuffer
+	logger := slog.New(slog.NewJSONHandler(&buf, &slog.HandlerOptions{Level: slog.LevelDebug - 4}))
+
+	loggerEnv, err := runtime.NewEnvWithLogger("test-logger", LoggingLevelVerbose, logger)
+	if err != nil {
+		t.Fatalf("Failed to create environment: %v", err)
+	}
+	env, err := runtime.NewEnv("test", LoggingLevelVerbose)
+	if err != nil {
+		loggerEnv.Close()
+		t.Fatalf("Failed to create environment: %v", err)
+	}
+	defer env.Close()
+
+	// The native environment outlives loggerEnv, and so does its logger
+	loggerEnv.Close()
+	logged := len(buf.String())
+
+	session, err := runtime.NewSession(env, testModelPath(), nil)
+	if err != nil {
+		t.Fatalf("Failed to create session: %v", err)
+	}
+	session.Close()
+
+	if len(buf.String()) == logged {
+		t.Error("Expected log messages of the shared environment to be written to the logger")
+	}
+}
+
+func TestSlogLevel(t *testing.T) {
+	testCases := []struct {
+		level    LoggingLevel
+		expected slog.Level
+	}{
+		{LoggingLevelVerbose, slog.LevelDebug - 4},
+		{LoggingLevelInfo, slog.LevelInfo},
+		{LoggingLevelWarning, slog.LevelWarn},
+		{LoggingLevelError, slog.LevelError},
+		{LoggingLevelFatal, slog.LevelError + 4},
+	}
+
+	for _, tc := range testCases {
+		if got := slogLevel(tc.level); got != tc.expected {
+			t.Errorf("slogLevel(%d) = %v, expected %v", tc.level, got, tc.expected)
+		}
+	}
+}
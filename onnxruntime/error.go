@@ -1,15 +1,138 @@
 package onnxruntime
 
 import (
+	"errors"
 	"fmt"
 )
 
+// Sentinel errors matching a RuntimeError with the corresponding error code.
+// For example, errors.Is(err, ErrInvalidArgument) reports whether err wraps a
+// RuntimeError with ErrorCodeInvalidArgument.
+var (
+	// ErrFail matches a RuntimeError with ErrorCodeFail.
+	ErrFail = errors.New("onnxruntime: failure")
+	// ErrInvalidArgument matches a RuntimeError with ErrorCodeInvalidArgument.
+	ErrInvalidArgument = errors.New("onnxruntime: invalid argument")
+	// ErrNoSuchFile matches a RuntimeError with ErrorCodeNoSuchFile.
+	ErrNoSuchFile = errors.New("onnxruntime: no such file")
+	// ErrNoModel matches a RuntimeError with ErrorCodeNoModel.
+	ErrNoModel = errors.New("onnxruntime: no model")
+	// ErrEngineError matches a RuntimeError with ErrorCodeEngineError.
+	ErrEngineError = errors.New("onnxruntime: engine error")
+	// ErrRuntimeException matches a RuntimeError with ErrorCodeRuntimeException.
+	ErrRuntimeException = errors.New("onnxruntime: runtime exception")
+	// ErrInvalidProtobuf matches a RuntimeError with ErrorCodeInvalidProtobuf.
+	ErrInvalidProtobuf = errors.New("onnxruntime: invalid protobuf")
+	// ErrModelLoaded matches a RuntimeError with ErrorCodeModelLoaded.
+	ErrModelLoaded = errors.New("onnxruntime: model loaded")
+	// ErrNotImplemented matches a RuntimeError with ErrorCodeNotImplemented.
+	ErrNotImplemented = errors.New("onnxruntime: not implemented")
+	// ErrInvalidGraph matches a RuntimeError with ErrorCodeInvalidGraph.
+	ErrInvalidGraph = errors.New("onnxruntime: invalid graph")
+	// ErrEPFail matches a RuntimeError with ErrorCodeEPFail.
+	ErrEPFail = errors.New("onnxruntime: execution provider failure")
+)
+
+// errorCodeSentinels maps error codes to their sentinel errors.
+var errorCodeSentinels = map[ErrorCode]error{
+	ErrorCodeFail:             ErrFail,
+	ErrorCodeInvalidArgument:  ErrInvalidArgument,
+	ErrorCodeNoSuchFile:       ErrNoSuchFile,
+	ErrorCodeNoModel:          ErrNoModel,
+	ErrorCodeEngineError:      ErrEngineError,
+	ErrorCodeRuntimeException: ErrRuntimeException,
+	ErrorCodeInvalidProtobuf:  ErrInvalidProtobuf,
+	ErrorCodeModelLoaded:      ErrModelLoaded,
+	ErrorCodeNotImplemented:   ErrNotImplemented,
+	ErrorCodeInvalidGraph:     ErrInvalidGraph,
+	ErrorCodeEPFail:           ErrEPFail,
+}
+
 // RuntimeError represents an error returned from the ONNX Runtime C API.
 type RuntimeError struct {
 	Code    ErrorCode
 	Message string
+	// Op is the name of the C API function that failed (e.g. "CreateSession").
+	Op string
 }
 
 func (e *RuntimeError) Error() string {
+	if e.Op != "" {
+		return fmt.Sprintf("onnxruntime error (code %d) in %s: %s", e.Code, e.Op, e.Message)
+	}
 	return fmt.Sprintf("onnxruntime error (code %d): %s", e.Code, e.Message)
 }
+
+// Is reports whether target is the sentinel error for the error code,
+// such as ErrInvalidArgument for ErrorCodeInvalidArgument.
+func (e *RuntimeError) Is(target error) bool {
+	sentinel, ok := errorCodeSentinels[e.Code]
+	return ok && target == sentinel
+}
+
+// UnknownInputError is returned by Session.Run, Session.Prepare and
+// IoBinding.BindInput when an input name does not match any input of the model.
+type UnknownInputError struct {
+	Name string
+}
+
+func (e *UnknownInputError) Error() string {
+	return fmt.Sprintf("unknown input %q", e.Name)
+}
+
+// UnknownOutputError is returned by Session.Prepare and the output binding
+// methods of IoBinding when an output name does not match any output of the model.
+type UnknownOutputError struct {
+	Name string
+}
+
+func (e *UnknownOutputError) Error() string {
+	return fmt.Sprintf("unknown output %q", e.Name)
+}
+
+// MissingInputError is returned by Session.Run and Session.Prepare when a
+// required input of the model is not provided.
+type MissingInputError struct {
+	Name string
+}
+
+func (e *MissingInputError) Error() string {
+	return fmt.Sprintf("missing required input %q", e.Name)
+}
+
+// ElementTypeMismatchError is returned by Session.Run when the element type
+// of an input tensor does not match the element type expected by the model.
+type ElementTypeMismatchError struct {
+	Name     string
+	Expected ONNXTensorElementDataType
+	Actual   ONNXTensorElementDataType
+}
+
+func (e *ElementTypeMismatchError) Error() string {
+	return fmt.Sprintf("input %q: element type mismatch: expected %d, got %d", e.Name, e.Expected, e.Actual)
+}
+
+// RankMismatchError is returned by Session.Run when the number of dimensions
+// of an input tensor does not match the rank expected by the model.
+type RankMismatchError struct {
+	Name     string
+	Expected int
+	Actual   int
+}
+
+func (e *RankMismatchError) Error() string {
+	return fmt.Sprintf("input %q: rank mismatch: expected %d, got %d", e.Name, e.Expected, e.Actual)
+}
+
+// DimensionMismatchError is returned by Session.Run when a dimension of an
+// input tensor does not match a fixed dimension expected by the model.
+type DimensionMismatchError struct {
+	Name     string
+	Axis     int
+	Expected int64
+	Actual   int64
+}
+
+func (e *DimensionMismatchError) Error() string {
+	return fmt.Sprintf("input %q: dimension %d mismatch: expected %d, got %d", e.Name, e.Axis, e.Expected, e.Actual)
+}
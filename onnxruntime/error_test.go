@@ -2,6 +2,7 @@ package onnxruntime
 
 import (
 	"errors"
+	"fmt"
 	"strings"
 	"testing"
 )
@@ -10,9 +11,9 @@ func TestStatusError(t *testing.T) {
 	runtime := newTestRuntime(t)
 
 	t.Run("nil status returns nil error", func(t *testing.T) {
-		err := runtime.statusError(0)
+		err := runtime.statusError(0, "Test")
 		if err != nil {
-			t.Errorf("runtime.statusError(0) should return nil, got %v", err)
+			t.Errorf("runtime.statusError(0, ...) should return nil, got %v", err)
 		}
 	})
 
@@ -35,7 +36,7 @@ func TestStatusError(t *testing.T) {
 					t.Fatal("createStatus should return non-zero status")
 				}
 
-				err := runtime.statusError(status)
+				err := runtime.statusError(status, "CreateStatus")
 				if err == nil {
 					t.Fatal("statusError should return an error")
 				}
@@ -49,6 +50,10 @@ func TestStatusError(t *testing.T) {
 					t.Errorf("Expected error code %d, got %d", tc.code, ortErr.Code)
 				}
 
+				if ortErr.Op != "CreateStatus" {
+					t.Errorf("Expected op %q, got %q", "CreateStatus", ortErr.Op)
+				}
+
 				if !strings.Contains(err.Error(), "error for "+tc.name) {
 					t.Errorf("Error message should contain 'error for %s', got: %s", tc.name, err.Error())
 				}
@@ -57,6 +62,48 @@ func TestStatusError(t *testing.T) {
 	})
 }
 
+func TestRuntimeErrorIs(t *testing.T) {
+	testCases := []struct {
+		code     ErrorCode
+		sentinel error
+	}{
+		{ErrorCodeFail, ErrFail},
+		{ErrorCodeInvalidArgument, ErrInvalidArgument},
+		{ErrorCodeNoSuchFile, ErrNoSuchFile},
+		{ErrorCodeNoModel, ErrNoModel},
+		{ErrorCodeEngineError, ErrEngineError},
+		{ErrorCodeRuntimeException, ErrRuntimeException},
+		{ErrorCodeInvalidProtobuf, ErrInvalidProtobuf},
+		{ErrorCodeModelLoaded, ErrModelLoaded},
+		{ErrorCodeNotImplemented, ErrNotImplemented},
+		{ErrorCodeInvalidGraph, ErrInvalidGraph},
+		{ErrorCodeEPFail, ErrEPFail},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.sentinel.Error(), func(t *testing.T) {
+			err := fmt.Errorf("failed to run inference: %w", &RuntimeError{Code: tc.code, Message: "test", Op: "Run"})
+
+			if !errors.Is(err, tc.sentinel) {
+				t.Errorf("errors.Is(%v, %v) should be true", err, tc.sentinel)
+			}
+			for _, other := range testCases {
+				if other.code != tc.code && errors.Is(err, other.sentinel) {
+					t.Errorf("errors.Is(%v, %v) should be false", err, other.sentinel)
+				}
+			}
+		})
+	}
+}
+
+func TestRuntimeErrorMessage(t *testing.T) {
+	err := &RuntimeError{Code: ErrorCodeNoSuchFile, Message: "file not found", Op: "CreateSession"}
+	expected := "onnxruntime error (code 3) in CreateSession: file not found"
+	if err.Error() != expected {
+		t.Errorf("Expected %q, got %q", expected, err.Error())
+	}
+}
+
 func TestStatusFunctions(t *testing.T) {
 	runtime := newTestRuntime(t)
 
@@ -0,0 +1,151 @@
+package onnxruntime
+
+import (
+	"math"
+	"strconv"
+)
+
+// Float16 is an IEEE 754 half-precision floating-point number stored as its raw bits.
+// It can be used as the element type of ONNXTensorElementDataTypeFloat16 tensors.
+type Float16 uint16
+
+// NewFloat16 converts a float32 to the nearest Float16, rounding half to even.
+// Values too large to be represented become infinity.
+func NewFloat16(f float32) Float16 {
+	bits := math.Float32bits(f)
+	sign := uint16(bits>>16) & 0x8000
+	exp := int32(bits>>23) & 0xff
+	mant := bits & 0x7fffff
+
+	// Infinity or NaN
+	if exp == 0xff {
+		if mant != 0 {
+			return Float16(sign | 0x7e00)
+		}
+		return Float16(sign | 0x7c00)
+	}
+
+	// Re-bias the exponent from float32 (127) to float16 (15)
+	e := exp - 127 + 15
+	if e >= 0x1f {
+		// Overflow
+		return Float16(sign | 0x7c00)
+	}
+
+	if e <= 0 {
+		// Subnormal or zero
+		if e < -10 {
+			return Float16(sign)
+		}
+		mant |= 0x800000
+		shift := uint32(14 - e)
+		half := mant >> shift
+		rem := mant & (1<<shift - 1)
+		halfway := uint32(1) << (shift - 1)
+		if rem > halfway || (rem == halfway && half&1 == 1) {
+			half++
+		}
+		return Float16(sign | uint16(half))
+	}
+
+	half := uint32(e)<<10 | mant>>13
+	rem := mant & 0x1fff
+	if rem > 0x1000 || (rem == 0x1000 && half&1 == 1) {
+		// Carrying into the exponent is intended and may produce infinity.
+		half++
+	}
+	return Float16(sign | uint16(half))
+}
+
+// Float32 converts the Float16 to a float32. The conversion is exact.
+func (h Float16) Float32() float32 {
+	sign := uint32(h&0x8000) << 16
+	exp := uint32(h>>10) & 0x1f
+	mant := uint32(h & 0x3ff)
+
+	switch exp {
+	case 0x1f:
+		// Infinity or NaN
+		return math.Float32frombits(sign | 0x7f800000 | mant<<13)
+	case 0:
+		if mant == 0 {
+			return math.Float32frombits(sign)
+		}
+		// Normalize the subnormal value
+		e := uint32(127 - 14)
+		for mant&0x400 == 0 {
+			mant <<= 1
+			e--
+		}
+		mant &= 0x3ff
+		return math.Float32frombits(sign | e<<23 | mant<<13)
+	default:
+		return math.Float32frombits(sign | (exp+127-15)<<23 | mant<<13)
+	}
+}
+
+// String returns the decimal representation of the Float16.
+func (h Float16) String() string {
+	return strconv.FormatFloat(float64(h.Float32()), 'g', -1, 32)
+}
+
+// BFloat16 is a bfloat16 (brain floating-point) number stored as its raw bits.
+// It can be used as the element type of ONNXTensorElementDataTypeBFloat16 tensors.
+type BFloat16 uint16
+
+// NewBFloat16 converts a float32 to the nearest BFloat16, rounding half to even.
+func NewBFloat16(f float32) BFloat16 {
+	bits := math.Float32bits(f)
+	if bits&0x7fffffff > 0x7f800000 {
+		// Keep NaN a quiet NaN after truncation
+		return BFloat16(bits>>16 | 0x40)
+	}
+	rounding := uint32(0x7fff) + (bits>>16)&1
+	return BFloat16((bits + rounding) >> 16)
+}
+
+// Float32 converts the BFloat16 to a float32. The conversion is exact.
+func (b BFloat16) Float32() float32 {
+	return math.Float32frombits(uint32(b) << 16)
+}
+
+// String returns the decimal representation of the BFloat16.
+func (b BFloat16) String() string {
+	return strconv.FormatFloat(float64(b.Float32()), 'g', -1, 32)
+}
+
+// Float16ToFloat32Slice converts a slice of Float16 values to float32.
+func Float16ToFloat32Slice(src []Float16) []float32 {
+	dst := make([]float32, len(src))
+	for i, h := range src {
+		dst[i] = h.Float32()
+	}
+	return dst
+}
+
+// Float32ToFloat16Slice converts a slice of float32 values to Float16.
+func Float32ToFloat16Slice(src []float32) []Float16 {
+	dst := make([]Float16, len(src))
+	for i, f := range src {
+		dst[i] = NewFloat16(f)
+	}
+	return dst
+}
+
+// BFloat16ToFloat32Slice converts a slice of BFloat16 values to float32.
+func BFloat16ToFloat32Slice(src []BFloat16) []float32 {
+	dst := make([]float32, len(src))
+	for i, b := range src {
+		dst[i] = b.Float32()
+	}
+	return dst
+}
+
+// Float32ToBFloat16Slice converts a slice of float32 values to BFloat16.
+func Float32ToBFloat16Slice(src []float32) []BFloat16 {
+	dst := make([]BFloat16, len(src))
+	for i, f := range src {
+		dst[i] = NewBFloat16(f)
+	}
+	return dst
+}
@@ -0,0 +1,112 @@
+package onnxruntime
+
+import (
+	"math"
+	"slices"
+	"testing"
+)
+
+func TestFloat16(t *testing.T) {
+	testCases := []struct {
+		name  string
+		input float32
+		bits  Float16
+		want  float32
+	}{
+		{"Zero", 0, 0x0000, 0},
+		{"NegativeZero", float32(math.Copysign(0, -1)), 0x8000, float32(math.Copysign(0, -1))},
+		{"One", 1, 0x3c00, 1},
+		{"MinusTwo", -2, 0xc000, -2},
+		{"Half", 0.5, 0x3800, 0.5},
+		{"Max", 65504, 0x7bff, 65504},
+		{"Overflow", 65520, 0x7c00, float32(math.Inf(1))},
+		{"Infinity", float32(math.Inf(1)), 0x7c00, float32(math.Inf(1))},
+		{"NegativeInfinity", float32(math.Inf(-1)), 0xfc00, float32(math.Inf(-1))},
+		{"MinNormal", 6.103515625e-05, 0x0400, 6.103515625e-05},
+		{"MinSubnormal", 5.9604645e-08, 0x0001, 5.9604645e-08},
+		{"Underflow", 2e-08, 0x0000, 0},
+		{"RoundToNearestEven", 1.00048828125, 0x3c00, 1},
+		{"RoundUp", 1.0009765625 + 0.00048828125, 0x3c02, 1.001953125},
+		{"OneThird", 1.0 / 3.0, 0x3555, 0.33325195},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			h := NewFloat16(tc.input)
+			if h != tc.bits {
+				t.Errorf("NewFloat16(%v) = %#04x, want %#04x", tc.input, uint16(h), uint16(tc.bits))
+			}
+			got := h.Float32()
+			if math.Float32bits(got) != math.Float32bits(tc.want) {
+				t.Errorf("Float16(%#04x).Float32() = %v, want %v", uint16(h), got, tc.want)
+			}
+		})
+	}
+
+	t.Run("NaN", func(t *testing.T) {
+		h := NewFloat16(float32(math.NaN()))
+		if !math.IsNaN(float64(h.Float32())) {
+			t.Errorf("Expected NaN, got %v", h.Float32())
+		}
+	})
+
+	t.Run("RoundTrip", func(t *testing.T) {
+		for bits := range 1 << 16 {
+			h := Float16(bits)
+			f := h.Float32()
+			if math.IsNaN(float64(f)) {
+				continue
+			}
+			if got := NewFloat16(f); got != h {
+				t.Fatalf("Round trip of %#04x produced %#04x", bits, uint16(got))
+			}
+		}
+	})
+}
+
+func TestBFloat16(t *testing.T) {
+	testCases := []struct {
+		name  string
+		input float32
+		bits  BFloat16
+		want  float32
+	}{
+		{"Zero", 0, 0x0000, 0},
+		{"One", 1, 0x3f80, 1},
+		{"MinusTwo", -2, 0xc000, -2},
+		{"Infinity", float32(math.Inf(1)), 0x7f80, float32(math.Inf(1))},
+		{"RoundToNearestEven", math.Float32frombits(0x3f808000), 0x3f80, 1},
+		{"RoundUp", math.Float32frombits(0x3f818000), 0x3f82, math.Float32frombits(0x3f820000)},
+		{"Truncate", math.Float32frombits(0x3f807fff), 0x3f80, 1},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			b := NewBFloat16(tc.input)
+			if b != tc.bits {
+				t.Errorf("NewBFloat16(%v) = %#04x, want %#04x", tc.input, uint16(b), uint16(tc.bits))
+			}
+			if got := b.Float32(); got != tc.want {
+				t.Errorf("BFloat16(%#04x).Float32() = %v, want %v", uint16(b), got, tc.want)
+			}
+		})
+	}
+
+	t.Run("NaN", func(t *testing.T) {
+		b := NewBFloat16(math.Float32frombits(0x7f800001))
+		if !math.IsNaN(float64(b.Float32())) {
+			t.Errorf("Expected NaN, got %v", b.Float32())
+		}
+	})
+}
+
+func TestFloat16SliceConversion(t *testing.T) {
+	data := []float32{0, 1, -2, 0.5, 65504}
+
+	if got := Float16ToFloat32Slice(Float32ToFloat16Slice(data)); !slices.Equal(got, data) {
+		t.Errorf("Float16 round trip mismatch: expected %v, got %v", data, got)
+	}
+	if got := BFloat16ToFloat32Slice(Float32ToBFloat16Slice(data[:4])); !slices.Equal(got, data[:4]) {
+		t.Errorf("BFloat16 round trip mismatch: expected %v, got %v", data[:4], got)
+	}
+}
@@ -26,6 +26,18 @@ type OrtMemoryInfo uintptr
 // OrtTensorTypeAndShapeInfo is an opaque pointer to ONNX Runtime tensor type and shape information.
 type OrtTensorTypeAndShapeInfo uintptr
 
+// OrtTypeInfo is an opaque pointer to ONNX Runtime type information.
+type OrtTypeInfo uintptr
+
+// OrtModelMetadata is an opaque pointer to ONNX Runtime model metadata.
+type OrtModelMetadata uintptr
+
+// OrtRunOptions is an opaque pointer to ONNX Runtime run options.
+type OrtRunOptions uintptr
+
+// OrtIoBinding is an opaque pointer to an ONNX Runtime I/O binding.
+type OrtIoBinding uintptr
+
 // OrtErrorCode represents error codes returned by the ONNX Runtime C API.
 type OrtErrorCode int32
 
@@ -44,6 +56,18 @@ type OrtAllocatorType int32
 // OrtMemType represents memory types for allocations.
 type OrtMemType int32
 
+// GraphOptimizationLevel represents graph optimization levels.
+type GraphOptimizationLevel int32
+
+// ExecutionMode represents session execution modes.
+type ExecutionMode int32
+
+// OrtSparseFormat represents the storage format of a sparse tensor.
+type OrtSparseFormat int32
+
+// OrtSparseIndicesFormat identifies the indices of a sparse tensor to access.
+type OrtSparseIndicesFormat int32
+
 // APIFuncs is an interface for ONNX Runtime C API functions.
 type APIFuncs interface {
 	// Status and error handling
@@ -54,6 +78,7 @@ type APIFuncs interface {
 
 	// Environment
 	CreateEnv(OrtLoggingLevel, *byte, *OrtEnv) OrtStatus
+	CreateEnvWithCustomLogger(uintptr, uintptr, OrtLoggingLevel, *byte, *OrtEnv) OrtStatus
 	ReleaseEnv(OrtEnv)
 
 	// Allocator
@@ -62,11 +87,24 @@ type APIFuncs interface {
 
 	// Memory info
 	CreateCpuMemoryInfo(OrtAllocatorType, OrtMemType, *OrtMemoryInfo) OrtStatus
+	CreateMemoryInfo(*byte, OrtAllocatorType, int32, OrtMemType, *OrtMemoryInfo) OrtStatus
 	ReleaseMemoryInfo(OrtMemoryInfo)
 
 	// Session options
 	CreateSessionOptions(*OrtSessionOptions) OrtStatus
+	SetOptimizedModelFilePath(OrtSessionOptions, *byte) OrtStatus
 	SetIntraOpNumThreads(OrtSessionOptions, int32) OrtStatus
+	SetInterOpNumThreads(OrtSessionOptions, int32) OrtStatus
+	SetSessionGraphOptimizationLevel(OrtSessionOptions, GraphOptimizationLevel) OrtStatus
+	SetSessionExecutionMode(OrtSessionOptions, ExecutionMode) OrtStatus
+	EnableMemPattern(OrtSessionOptions) OrtStatus
+	DisableMemPattern(OrtSessionOptions) OrtStatus
+	EnableCpuMemArena(OrtSessionOptions) OrtStatus
+	DisableCpuMemArena(OrtSessionOptions) OrtStatus
+	SetDeterministicCompute(OrtSessionOptions, bool) OrtStatus
+	EnableProfiling(OrtSessionOptions, *byte) OrtStatus
+	DisableProfiling(OrtSessionOptions) OrtStatus
+	AddSessionConfigEntry(OrtSessionOptions, *byte, *byte) OrtStatus
 	SessionOptionsAppendExecutionProvider(OrtSessionOptions, *byte, **byte, **byte, uintptr) OrtStatus
 	ReleaseSessionOptions(OrtSessionOptions)
 
@@ -77,21 +115,84 @@ type APIFuncs interface {
 	SessionGetOutputCount(OrtSession, *uintptr) OrtStatus
 	SessionGetInputName(OrtSession, uintptr, OrtAllocator, **byte) OrtStatus
 	SessionGetOutputName(OrtSession, uintptr, OrtAllocator, **byte) OrtStatus
-	Run(OrtSession, uintptr, **byte, *OrtValue, uintptr, **byte, uintptr, *OrtValue) OrtStatus
+	SessionGetInputTypeInfo(OrtSession, uintptr, *OrtTypeInfo) OrtStatus
+	SessionGetOutputTypeInfo(OrtSession, uintptr, *OrtTypeInfo) OrtStatus
+	SessionEndProfiling(OrtSession, OrtAllocator, **byte) OrtStatus
+	Run(OrtSession, OrtRunOptions, **byte, *OrtValue, uintptr, **byte, uintptr, *OrtValue) OrtStatus
+	RunAsync(OrtSession, OrtRunOptions, **byte, *OrtValue, uintptr, **byte, uintptr, *OrtValue, uintptr, uintptr) OrtStatus
 	ReleaseSession(OrtSession)
 
+	// Run options
+	CreateRunOptions(*OrtRunOptions) OrtStatus
+	RunOptionsSetRunTag(OrtRunOptions, *byte) OrtStatus
+	RunOptionsSetRunLogSeverityLevel(OrtRunOptions, int32) OrtStatus
+	RunOptionsSetRunLogVerbosityLevel(OrtRunOptions, int32) OrtStatus
+	AddRunConfigEntry(OrtRunOptions, *byte, *byte) OrtStatus
+	RunOptionsSetTerminate(OrtRunOptions) OrtStatus
+	RunOptionsUnsetTerminate(OrtRunOptions) OrtStatus
+	ReleaseRunOptions(OrtRunOptions)
+
 	// Tensor/Value operations
+	CreateTensorAsOrtValue(OrtAllocator, *int64, uintptr, ONNXTensorElementDataType, *OrtValue) OrtStatus
 	CreateTensorWithDataAsOrtValue(OrtMemoryInfo, unsafe.Pointer, uintptr, *int64, uintptr, ONNXTensorElementDataType, *OrtValue) OrtStatus
 	GetValueType(OrtValue, *ONNXType) OrtStatus
+	GetValue(OrtValue, int32, OrtAllocator, *OrtValue) OrtStatus
+	GetValueCount(OrtValue, *uintptr) OrtStatus
+	CreateValue(*OrtValue, uintptr, ONNXType, *OrtValue) OrtStatus
+	HasValue(OrtValue, *int32) OrtStatus
 	GetTensorMutableData(OrtValue, *unsafe.Pointer) OrtStatus
+	FillStringTensor(OrtValue, **byte, uintptr) OrtStatus
+	GetStringTensorDataLength(OrtValue, *uintptr) OrtStatus
+	GetStringTensorContent(OrtValue, unsafe.Pointer, uintptr, *uintptr, uintptr) OrtStatus
 	GetTensorTypeAndShape(OrtValue, *OrtTensorTypeAndShapeInfo) OrtStatus
 	GetTensorElementType(OrtTensorTypeAndShapeInfo, *ONNXTensorElementDataType) OrtStatus
 	GetDimensionsCount(OrtTensorTypeAndShapeInfo, *uintptr) OrtStatus
 	GetDimensions(OrtTensorTypeAndShapeInfo, *int64, uintptr) OrtStatus
+	GetSymbolicDimensions(OrtTensorTypeAndShapeInfo, **byte, uintptr) OrtStatus
 	GetTensorShapeElementCount(OrtTensorTypeAndShapeInfo, *uintptr) OrtStatus
 	ReleaseValue(OrtValue)
 	ReleaseTensorTypeAndShapeInfo(OrtTensorTypeAndShapeInfo)
 
+	// Type info
+	GetOnnxTypeFromTypeInfo(OrtTypeInfo, *ONNXType) OrtStatus
+	CastTypeInfoToTensorInfo(OrtTypeInfo, *OrtTensorTypeAndShapeInfo) OrtStatus
+	ReleaseTypeInfo(OrtTypeInfo)
+
+	// Model metadata
+	SessionGetModelMetadata(OrtSession, *OrtModelMetadata) OrtStatus
+	ModelMetadataGetProducerName(OrtModelMetadata, OrtAllocator, **byte) OrtStatus
+	ModelMetadataGetGraphName(OrtModelMetadata, OrtAllocator, **byte) OrtStatus
+	ModelMetadataGetDomain(OrtModelMetadata, OrtAllocator, **byte) OrtStatus
+	ModelMetadataGetDescription(OrtModelMetadata, OrtAllocator, **byte) OrtStatus
+	ModelMetadataGetGraphDescription(OrtModelMetadata, OrtAllocator, **byte) OrtStatus
+	ModelMetadataGetVersion(OrtModelMetadata, *int64) OrtStatus
+	ModelMetadataGetCustomMetadataMapKeys(OrtModelMetadata, OrtAllocator, ***byte, *int64) OrtStatus
+	ModelMetadataLookupCustomMetadataMap(OrtModelMetadata, OrtAllocator, *byte, **byte) OrtStatus
+	ReleaseModelMetadata(OrtModelMetadata)
+
+	// I/O binding
+	CreateIoBinding(OrtSession, *OrtIoBinding) OrtStatus
+	BindInput(OrtIoBinding, *byte, OrtValue) OrtStatus
+	BindOutput(OrtIoBinding, *byte, OrtValue) OrtStatus
+	BindOutputToDevice(OrtIoBinding, *byte, OrtMemoryInfo) OrtStatus
+	GetBoundOutputNames(OrtIoBinding, OrtAllocator, **byte, **uintptr, *uintptr) OrtStatus
+	GetBoundOutputValues(OrtIoBinding, OrtAllocator, **OrtValue, *uintptr) OrtStatus
+	ClearBoundInputs(OrtIoBinding)
+	ClearBoundOutputs(OrtIoBinding)
+	RunWithBinding(OrtSession, OrtRunOptions, OrtIoBinding) OrtStatus
+	ReleaseIoBinding(OrtIoBinding)
+
+	// Sparse tensors
+	CreateSparseTensorWithValuesAsOrtValue(OrtMemoryInfo, unsafe.Pointer, *int64, uintptr, *int64, uintptr, ONNXTensorElementDataType, *OrtValue) OrtStatus
+	UseCooIndices(OrtValue, *int64, uintptr) OrtStatus
+	UseCsrIndices(OrtValue, *int64, uintptr, *int64, uintptr) OrtStatus
+	UseBlockSparseIndices(OrtValue, *int64, uintptr, *int32) OrtStatus
+	GetSparseTensorFormat(OrtValue, *OrtSparseFormat) OrtStatus
+	GetSparseTensorValuesTypeAndShape(OrtValue, *OrtTensorTypeAndShapeInfo) OrtStatus
+	GetSparseTensorValues(OrtValue, *unsafe.Pointer) OrtStatus
+	GetSparseTensorIndicesTypeShape(OrtValue, OrtSparseIndicesFormat, *OrtTensorTypeAndShapeInfo) OrtStatus
+	GetSparseTensorIndices(OrtValue, OrtSparseIndicesFormat, *uintptr, *unsafe.Pointer) OrtStatus
+
 	// Execution provider information
 	GetAvailableProviders(***byte, *int32) OrtStatus
 	ReleaseAvailableProviders(**byte, int32) OrtStatus
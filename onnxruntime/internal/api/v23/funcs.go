@@ -17,8 +17,9 @@ type Funcs struct {
 	releaseStatus   func(api.OrtStatus)
 
 	// Environment
-	createEnv  func(api.OrtLoggingLevel, *byte, *api.OrtEnv) api.OrtStatus
-	releaseEnv func(api.OrtEnv)
+	createEnv                 func(api.OrtLoggingLevel, *byte, *api.OrtEnv) api.OrtStatus
+	createEnvWithCustomLogger func(uintptr, uintptr, api.OrtLoggingLevel, *byte, *api.OrtEnv) api.OrtStatus
+	releaseEnv                func(api.OrtEnv)
 
 	// Allocator
 	getAllocatorWithDefaultOptions func(*api.OrtAllocator) api.OrtStatus
@@ -26,36 +27,112 @@ type Funcs struct {
 
 	// Memory info
 	createCpuMemoryInfo func(api.OrtAllocatorType, api.OrtMemType, *api.OrtMemoryInfo) api.OrtStatus
+	createMemoryInfo    func(*byte, api.OrtAllocatorType, int32, api.OrtMemType, *api.OrtMemoryInfo) api.OrtStatus
 	releaseMemoryInfo   func(api.OrtMemoryInfo)
 
 	// Session options
 	createSessionOptions                  func(*api.OrtSessionOptions) api.OrtStatus
+	setOptimizedModelFilePath             func(api.OrtSessionOptions, *byte) api.OrtStatus
 	setIntraOpNumThreads                  func(api.OrtSessionOptions, int32) api.OrtStatus
+	setInterOpNumThreads                  func(api.OrtSessionOptions, int32) api.OrtStatus
+	setSessionGraphOptimizationLevel      func(api.OrtSessionOptions, api.GraphOptimizationLevel) api.OrtStatus
+	setSessionExecutionMode               func(api.OrtSessionOptions, api.ExecutionMode) api.OrtStatus
+	enableMemPattern                      func(api.OrtSessionOptions) api.OrtStatus
+	disableMemPattern                     func(api.OrtSessionOptions) api.OrtStatus
+	enableCpuMemArena                     func(api.OrtSessionOptions) api.OrtStatus
+	disableCpuMemArena                    func(api.OrtSessionOptions) api.OrtStatus
+	setDeterministicCompute               func(api.OrtSessionOptions, bool) api.OrtStatus
+	enableProfiling                       func(api.OrtSessionOptions, *byte) api.OrtStatus
+	disableProfiling                      func(api.OrtSessionOptions) api.OrtStatus
+	addSessionConfigEntry                 func(api.OrtSessionOptions, *byte, *byte) api.OrtStatus
 	sessionOptionsAppendExecutionProvider func(api.OrtSessionOptions, *byte, **byte, **byte, uintptr) api.OrtStatus
 	releaseSessionOptions                 func(api.OrtSessionOptions)
 
 	// Session
-	createSession          func(api.OrtEnv, *byte, api.OrtSessionOptions, *api.OrtSession) api.OrtStatus
-	createSessionFromArray func(api.OrtEnv, unsafe.Pointer, uintptr, api.OrtSessionOptions, *api.OrtSession) api.OrtStatus
-	sessionGetInputCount   func(api.OrtSession, *uintptr) api.OrtStatus
-	sessionGetOutputCount  func(api.OrtSession, *uintptr) api.OrtStatus
-	sessionGetInputName    func(api.OrtSession, uintptr, api.OrtAllocator, **byte) api.OrtStatus
-	sessionGetOutputName   func(api.OrtSession, uintptr, api.OrtAllocator, **byte) api.OrtStatus
-	run                    func(api.OrtSession, uintptr, **byte, *api.OrtValue, uintptr, **byte, uintptr, *api.OrtValue) api.OrtStatus
-	releaseSession         func(api.OrtSession)
+	createSession            func(api.OrtEnv, *byte, api.OrtSessionOptions, *api.OrtSession) api.OrtStatus
+	createSessionFromArray   func(api.OrtEnv, unsafe.Pointer, uintptr, api.OrtSessionOptions, *api.OrtSession) api.OrtStatus
+	sessionGetInputCount     func(api.OrtSession, *uintptr) api.OrtStatus
+	sessionGetOutputCount    func(api.OrtSession, *uintptr) api.OrtStatus
+	sessionGetInputName      func(api.OrtSession, uintptr, api.OrtAllocator, **byte) api.OrtStatus
+	sessionGetOutputName     func(api.OrtSession, uintptr, api.OrtAllocator, **byte) api.OrtStatus
+	sessionGetInputTypeInfo  func(api.OrtSession, uintptr, *api.OrtTypeInfo) api.OrtStatus
+	sessionGetOutputTypeInfo func(api.OrtSession, uintptr, *api.OrtTypeInfo) api.OrtStatus
+	sessionEndProfiling      func(api.OrtSession, api.OrtAllocator, **byte) api.OrtStatus
+	run                      func(api.OrtSession, api.OrtRunOptions, **byte, *api.OrtValue, uintptr, **byte, uintptr, *api.OrtValue) api.OrtStatus
+	runAsync                 func(api.OrtSession, api.OrtRunOptions, **byte, *api.OrtValue, uintptr, **byte, uintptr, *api.OrtValue, uintptr, uintptr) api.OrtStatus
+	releaseSession           func(api.OrtSession)
+
+	// Run options
+	createRunOptions                  func(*api.OrtRunOptions) api.OrtStatus
+	runOptionsSetRunTag               func(api.OrtRunOptions, *byte) api.OrtStatus
+	runOptionsSetRunLogSeverityLevel  func(api.OrtRunOptions, int32) api.OrtStatus
+	runOptionsSetRunLogVerbosityLevel func(api.OrtRunOptions, int32) api.OrtStatus
+	addRunConfigEntry                 func(api.OrtRunOptions, *byte, *byte) api.OrtStatus
+	runOptionsSetTerminate            func(api.OrtRunOptions) api.OrtStatus
+	runOptionsUnsetTerminate          func(api.OrtRunOptions) api.OrtStatus
+	releaseRunOptions                 func(api.OrtRunOptions)
 
 	// Tensor/Value operations
+	createTensorAsOrtValue         func(api.OrtAllocator, *int64, uintptr, api.ONNXTensorElementDataType, *api.OrtValue) api.OrtStatus
 	createTensorWithDataAsOrtValue func(api.OrtMemoryInfo, unsafe.Pointer, uintptr, *int64, uintptr, api.ONNXTensorElementDataType, *api.OrtValue) api.OrtStatus
 	getValueType                   func(api.OrtValue, *api.ONNXType) api.OrtStatus
+	getValue                       func(api.OrtValue, int32, api.OrtAllocator, *api.OrtValue) api.OrtStatus
+	getValueCount                  func(api.OrtValue, *uintptr) api.OrtStatus
+	createValue                    func(*api.OrtValue, uintptr, api.ONNXType, *api.OrtValue) api.OrtStatus
+	hasValue                       func(api.OrtValue, *int32) api.OrtStatus
 	getTensorMutableData           func(api.OrtValue, *unsafe.Pointer) api.OrtStatus
+	fillStringTensor               func(api.OrtValue, **byte, uintptr) api.OrtStatus
+	getStringTensorDataLength      func(api.OrtValue, *uintptr) api.OrtStatus
+	getStringTensorContent         func(api.OrtValue, unsafe.Pointer, uintptr, *uintptr, uintptr) api.OrtStatus
 	getTensorTypeAndShape          func(api.OrtValue, *api.OrtTensorTypeAndShapeInfo) api.OrtStatus
 	getTensorElementType           func(api.OrtTensorTypeAndShapeInfo, *api.ONNXTensorElementDataType) api.OrtStatus
 	getDimensionsCount             func(api.OrtTensorTypeAndShapeInfo, *uintptr) api.OrtStatus
 	getDimensions                  func(api.OrtTensorTypeAndShapeInfo, *int64, uintptr) api.OrtStatus
+	getSymbolicDimensions          func(api.OrtTensorTypeAndShapeInfo, **byte, uintptr) api.OrtStatus
 	getTensorShapeElementCount     func(api.OrtTensorTypeAndShapeInfo, *uintptr) api.OrtStatus
 	releaseValue                   func(api.OrtValue)
 	releaseTensorTypeAndShapeInfo  func(api.OrtTensorTypeAndShapeInfo)
 
+	// Type info
+	getOnnxTypeFromTypeInfo  func(api.OrtTypeInfo, *api.ONNXType) api.OrtStatus
+	castTypeInfoToTensorInfo func(api.OrtTypeInfo, *api.OrtTensorTypeAndShapeInfo) api.OrtStatus
+	releaseTypeInfo          func(api.OrtTypeInfo)
+
+	// Model metadata
+	sessionGetModelMetadata               func(api.OrtSession, *api.OrtModelMetadata) api.OrtStatus
+	modelMetadataGetProducerName          func(api.OrtModelMetadata, api.OrtAllocator, **byte) api.OrtStatus
+	modelMetadataGetGraphName             func(api.OrtModelMetadata, api.OrtAllocator, **byte) api.OrtStatus
+	modelMetadataGetDomain                func(api.OrtModelMetadata, api.OrtAllocator, **byte) api.OrtStatus
+	modelMetadataGetDescription           func(api.OrtModelMetadata, api.OrtAllocator, **byte) api.OrtStatus
+	modelMetadataGetGraphDescription      func(api.OrtModelMetadata, api.OrtAllocator, **byte) api.OrtStatus
+	modelMetadataGetVersion               func(api.OrtModelMetadata, *int64) api.OrtStatus
+	modelMetadataGetCustomMetadataMapKeys func(api.OrtModelMetadata, api.OrtAllocator, ***byte, *int64) api.OrtStatus
+	modelMetadataLookupCustomMetadataMap  func(api.OrtModelMetadata, api.OrtAllocator, *byte, **byte) api.OrtStatus
+	releaseModelMetadata                  func(api.OrtModelMetadata)
+
+	// I/O binding
+	createIoBinding      func(api.OrtSession, *api.OrtIoBinding) api.OrtStatus
+	bindInput            func(api.OrtIoBinding, *byte, api.OrtValue) api.OrtStatus
+	bindOutput           func(api.OrtIoBinding, *byte, api.OrtValue) api.OrtStatus
+	bindOutputToDevice   func(api.OrtIoBinding, *byte, api.OrtMemoryInfo) api.OrtStatus
+	getBoundOutputNames  func(api.OrtIoBinding, api.OrtAllocator, **byte, **uintptr, *uintptr) api.OrtStatus
+	getBoundOutputValues func(api.OrtIoBinding, api.OrtAllocator, **api.OrtValue, *uintptr) api.OrtStatus
+	clearBoundInputs     func(api.OrtIoBinding)
+	clearBoundOutputs    func(api.OrtIoBinding)
+	runWithBinding       func(api.OrtSession, api.OrtRunOptions, api.OrtIoBinding) api.OrtStatus
+	releaseIoBinding     func(api.OrtIoBinding)
+
+	// Sparse tensors
+	createSparseTensorWithValuesAsOrtValue func(api.OrtMemoryInfo, unsafe.Pointer, *int64, uintptr, *int64, uintptr, api.ONNXTensorElementDataType, *api.OrtValue) api.OrtStatus
+	useCooIndices                          func(api.OrtValue, *int64, uintptr) api.OrtStatus
+	useCsrIndices                          func(api.OrtValue, *int64, uintptr, *int64, uintptr) api.OrtStatus
+	useBlockSparseIndices                  func(api.OrtValue, *int64, uintptr, *int32) api.OrtStatus
+	getSparseTensorFormat                  func(api.OrtValue, *api.OrtSparseFormat) api.OrtStatus
+	getSparseTensorValuesTypeAndShape      func(api.OrtValue, *api.OrtTensorTypeAndShapeInfo) api.OrtStatus
+	getSparseTensorValues                  func(api.OrtValue, *unsafe.Pointer) api.OrtStatus
+	getSparseTensorIndicesTypeShape        func(api.OrtValue, api.OrtSparseIndicesFormat, *api.OrtTensorTypeAndShapeInfo) api.OrtStatus
+	getSparseTensorIndices                 func(api.OrtValue, api.OrtSparseIndicesFormat, *uintptr, *unsafe.Pointer) api.OrtStatus
+
 	// Execution provider information
 	getAvailableProviders     func(***byte, *int32) api.OrtStatus
 	releaseAvailableProviders func(**byte, int32) api.OrtStatus
@@ -93,16 +170,30 @@ func InitializeFuncs(libraryHandle uintptr) (*Funcs, error) {
 	purego.RegisterFunc(&funcs.releaseStatus, api.ReleaseStatus)
 
 	purego.RegisterFunc(&funcs.createEnv, api.CreateEnv)
+	purego.RegisterFunc(&funcs.createEnvWithCustomLogger, api.CreateEnvWithCustomLogger)
 	purego.RegisterFunc(&funcs.releaseEnv, api.ReleaseEnv)
 
 	purego.RegisterFunc(&funcs.getAllocatorWithDefaultOptions, api.GetAllocatorWithDefaultOptions)
 	purego.RegisterFunc(&funcs.allocatorFree, api.AllocatorFree)
 
 	purego.RegisterFunc(&funcs.createCpuMemoryInfo, api.CreateCpuMemoryInfo)
+	purego.RegisterFunc(&funcs.createMemoryInfo, api.CreateMemoryInfo)
 	purego.RegisterFunc(&funcs.releaseMemoryInfo, api.ReleaseMemoryInfo)
 
 	purego.RegisterFunc(&funcs.createSessionOptions, api.CreateSessionOptions)
+	purego.RegisterFunc(&funcs.setOptimizedModelFilePath, api.SetOptimizedModelFilePath)
 	purego.RegisterFunc(&funcs.setIntraOpNumThreads, api.SetIntraOpNumThreads)
+	purego.RegisterFunc(&funcs.setInterOpNumThreads, api.SetInterOpNumThreads)
+	purego.RegisterFunc(&funcs.setSessionGraphOptimizationLevel, api.SetSessionGraphOptimizationLevel)
+	purego.RegisterFunc(&funcs.setSessionExecutionMode, api.SetSessionExecutionMode)
+	purego.RegisterFunc(&funcs.enableMemPattern, api.EnableMemPattern)
+	purego.RegisterFunc(&funcs.disableMemPattern, api.DisableMemPattern)
+	purego.RegisterFunc(&funcs.enableCpuMemArena, api.EnableCpuMemArena)
+	purego.RegisterFunc(&funcs.disableCpuMemArena, api.DisableCpuMemArena)
+	purego.RegisterFunc(&funcs.setDeterministicCompute, api.SetDeterministicCompute)
+	purego.RegisterFunc(&funcs.enableProfiling, api.EnableProfiling)
+	purego.RegisterFunc(&funcs.disableProfiling, api.DisableProfiling)
+	purego.RegisterFunc(&funcs.addSessionConfigEntry, api.AddSessionConfigEntry)
 	purego.RegisterFunc(&funcs.sessionOptionsAppendExecutionProvider, api.SessionOptionsAppendExecutionProvider)
 	purego.RegisterFunc(&funcs.releaseSessionOptions, api.ReleaseSessionOptions)
 
@@ -112,20 +203,78 @@ func InitializeFuncs(libraryHandle uintptr) (*Funcs, error) {
 	purego.RegisterFunc(&funcs.sessionGetOutputCount, api.SessionGetOutputCount)
 	purego.RegisterFunc(&funcs.sessionGetInputName, api.SessionGetInputName)
 	purego.RegisterFunc(&funcs.sessionGetOutputName, api.SessionGetOutputName)
+	purego.RegisterFunc(&funcs.sessionGetInputTypeInfo, api.SessionGetInputTypeInfo)
+	purego.RegisterFunc(&funcs.sessionGetOutputTypeInfo, api.SessionGetOutputTypeInfo)
+	purego.RegisterFunc(&funcs.sessionEndProfiling, api.SessionEndProfiling)
 	purego.RegisterFunc(&funcs.run, api.Run)
+	purego.RegisterFunc(&funcs.runAsync, api.RunAsync)
 	purego.RegisterFunc(&funcs.releaseSession, api.ReleaseSession)
 
+	purego.RegisterFunc(&funcs.createRunOptions, api.CreateRunOptions)
+	purego.RegisterFunc(&funcs.runOptionsSetRunTag, api.RunOptionsSetRunTag)
+	purego.RegisterFunc(&funcs.runOptionsSetRunLogSeverityLevel, api.RunOptionsSetRunLogSeverityLevel)
+	purego.RegisterFunc(&funcs.runOptionsSetRunLogVerbosityLevel, api.RunOptionsSetRunLogVerbosityLevel)
+	purego.RegisterFunc(&funcs.addRunConfigEntry, api.AddRunConfigEntry)
+	purego.RegisterFunc(&funcs.runOptionsSetTerminate, api.RunOptionsSetTerminate)
+	purego.RegisterFunc(&funcs.runOptionsUnsetTerminate, api.RunOptionsUnsetTerminate)
+	purego.RegisterFunc(&funcs.releaseRunOptions, api.ReleaseRunOptions)
+
+	purego.RegisterFunc(&funcs.createTensorAsOrtValue, api.CreateTensorAsOrtValue)
 	purego.RegisterFunc(&funcs.createTensorWithDataAsOrtValue, api.CreateTensorWithDataAsOrtValue)
 	purego.RegisterFunc(&funcs.getValueType, api.GetValueType)
+	purego.RegisterFunc(&funcs.getValue, api.GetValue)
+	purego.RegisterFunc(&funcs.getValueCount, api.GetValueCount)
+	purego.RegisterFunc(&funcs.createValue, api.CreateValue)
+	purego.RegisterFunc(&funcs.hasValue, api.HasValue)
 	purego.RegisterFunc(&funcs.getTensorMutableData, api.GetTensorMutableData)
+	purego.RegisterFunc(&funcs.fillStringTensor, api.FillStringTensor)
+	purego.RegisterFunc(&funcs.getStringTensorDataLength, api.GetStringTensorDataLength)
+	purego.RegisterFunc(&funcs.getStringTensorContent, api.GetStringTensorContent)
 	purego.RegisterFunc(&funcs.getTensorTypeAndShape, api.GetTensorTypeAndShape)
 	purego.RegisterFunc(&funcs.getTensorElementType, api.GetTensorElementType)
 	purego.RegisterFunc(&funcs.getDimensionsCount, api.GetDimensionsCount)
 	purego.RegisterFunc(&funcs.getDimensions, api.GetDimensions)
+	purego.RegisterFunc(&funcs.getSymbolicDimensions, api.GetSymbolicDimensions)
 	purego.RegisterFunc(&funcs.getTensorShapeElementCount, api.GetTensorShapeElementCount)
 	purego.RegisterFunc(&funcs.releaseValue, api.ReleaseValue)
 	purego.RegisterFunc(&funcs.releaseTensorTypeAndShapeInfo, api.ReleaseTensorTypeAndShapeInfo)
 
+	purego.RegisterFunc(&funcs.getOnnxTypeFromTypeInfo, api.GetOnnxTypeFromTypeInfo)
+	purego.RegisterFunc(&funcs.castTypeInfoToTensorInfo, api.CastTypeInfoToTensorInfo)
+	purego.RegisterFunc(&funcs.releaseTypeInfo, api.ReleaseTypeInfo)
+
+	purego.RegisterFunc(&funcs.sessionGetModelMetadata, api.SessionGetModelMetadata)
+	purego.RegisterFunc(&funcs.modelMetadataGetProducerName, api.ModelMetadataGetProducerName)
+	purego.RegisterFunc(&funcs.modelMetadataGetGraphName, api.ModelMetadataGetGraphName)
+	purego.RegisterFunc(&funcs.modelMetadataGetDomain, api.ModelMetadataGetDomain)
+	purego.RegisterFunc(&funcs.modelMetadataGetDescription, api.ModelMetadataGetDescription)
+	purego.RegisterFunc(&funcs.modelMetadataGetGraphDescription, api.ModelMetadataGetGraphDescription)
+	purego.RegisterFunc(&funcs.modelMetadataGetVersion, api.ModelMetadataGetVersion)
+	purego.RegisterFunc(&funcs.modelMetadataGetCustomMetadataMapKeys, api.ModelMetadataGetCustomMetadataMapKeys)
+	purego.RegisterFunc(&funcs.modelMetadataLookupCustomMetadataMap, api.ModelMetadataLookupCustomMetadataMap)
+	purego.RegisterFunc(&funcs.releaseModelMetadata, api.ReleaseModelMetadata)
+
+	purego.RegisterFunc(&funcs.createIoBinding, api.CreateIoBinding)
+	purego.RegisterFunc(&funcs.bindInput, api.BindInput)
+	purego.RegisterFunc(&funcs.bindOutput, api.BindOutput)
+	purego.RegisterFunc(&funcs.bindOutputToDevice, api.BindOutputToDevice)
+	purego.RegisterFunc(&funcs.getBoundOutputNames, api.GetBoundOutputNames)
+	purego.RegisterFunc(&funcs.getBoundOutputValues, api.GetBoundOutputValues)
+	purego.RegisterFunc(&funcs.clearBoundInputs, api.ClearBoundInputs)
+	purego.RegisterFunc(&funcs.clearBoundOutputs, api.ClearBoundOutputs)
+	purego.RegisterFunc(&funcs.runWithBinding, api.RunWithBinding)
+	purego.RegisterFunc(&funcs.releaseIoBinding, api.ReleaseIoBinding)
+
+	purego.RegisterFunc(&funcs.createSparseTensorWithValuesAsOrtValue, api.CreateSparseTensorWithValuesAsOrtValue)
+	purego.RegisterFunc(&funcs.useCooIndices, api.UseCooIndices)
+	purego.RegisterFunc(&funcs.useCsrIndices, api.UseCsrIndices)
+	purego.RegisterFunc(&funcs.useBlockSparseIndices, api.UseBlockSparseIndices)
+	purego.RegisterFunc(&funcs.getSparseTensorFormat, api.GetSparseTensorFormat)
+	purego.RegisterFunc(&funcs.getSparseTensorValuesTypeAndShape, api.GetSparseTensorValuesTypeAndShape)
+	purego.RegisterFunc(&funcs.getSparseTensorValues, api.GetSparseTensorValues)
+	purego.RegisterFunc(&funcs.getSparseTensorIndicesTypeShape, api.GetSparseTensorIndicesTypeShape)
+	purego.RegisterFunc(&funcs.getSparseTensorIndices, api.GetSparseTensorIndices)
+
 	purego.RegisterFunc(&funcs.getAvailableProviders, api.GetAvailableProviders)
 	purego.RegisterFunc(&funcs.releaseAvailableProviders, api.ReleaseAvailableProviders)
 
@@ -156,6 +305,10 @@ func (f *Funcs) CreateEnv(logLevel api.OrtLoggingLevel, logID *byte, env *api.Or
 	return f.createEnv(logLevel, logID, env)
 }
 
+func (f *Funcs) CreateEnvWithCustomLogger(loggingFunction uintptr, loggerParam uintptr, logLevel api.OrtLoggingLevel, logID *byte, env *api.OrtEnv) api.OrtStatus {
+	return f.createEnvWithCustomLogger(loggingFunction, loggerParam, logLevel, logID, env)
+}
+
 func (f *Funcs) ReleaseEnv(env api.OrtEnv) {
 	f.releaseEnv(env)
 }
@@ -176,6 +329,10 @@ func (f *Funcs) CreateCpuMemoryInfo(allocType api.OrtAllocatorType, memType api.
 	return f.createCpuMemoryInfo(allocType, memType, memInfo)
 }
 
+func (f *Funcs) CreateMemoryInfo(name *byte, allocType api.OrtAllocatorType, deviceID int32, memType api.OrtMemType, memInfo *api.OrtMemoryInfo) api.OrtStatus {
+	return f.createMemoryInfo(name, allocType, deviceID, memType, memInfo)
+}
+
 func (f *Funcs) ReleaseMemoryInfo(memInfo api.OrtMemoryInfo) {
 	f.releaseMemoryInfo(memInfo)
 }
@@ -186,10 +343,58 @@ func (f *Funcs) CreateSessionOptions(options *api.OrtSessionOptions) api.OrtStat
 	return f.createSessionOptions(options)
 }
 
+func (f *Funcs) SetOptimizedModelFilePath(options api.OrtSessionOptions, path *byte) api.OrtStatus {
+	return f.setOptimizedModelFilePath(options, path)
+}
+
 func (f *Funcs) SetIntraOpNumThreads(options api.OrtSessionOptions, numThreads int32) api.OrtStatus {
 	return f.setIntraOpNumThreads(options, numThreads)
 }
 
+func (f *Funcs) SetInterOpNumThreads(options api.OrtSessionOptions, numThreads int32) api.OrtStatus {
+	return f.setInterOpNumThreads(options, numThreads)
+}
+
+func (f *Funcs) SetSessionGraphOptimizationLevel(options api.OrtSessionOptions, level api.GraphOptimizationLevel) api.OrtStatus {
+	return f.setSessionGraphOptimizationLevel(options, level)
+}
+
+func (f *Funcs) SetSessionExecutionMode(options api.OrtSessionOptions, mode api.ExecutionMode) api.OrtStatus {
+	return f.setSessionExecutionMode(options, mode)
+}
+
+func (f *Funcs) EnableMemPattern(options api.OrtSessionOptions) api.OrtStatus {
+	return f.enableMemPattern(options)
+}
+
+func (f *Funcs) DisableMemPattern(options api.OrtSessionOptions) api.OrtStatus {
+	return f.disableMemPattern(options)
+}
+
+func (f *Funcs) EnableCpuMemArena(options api.OrtSessionOptions) api.OrtStatus {
+	return f.enableCpuMemArena(options)
+}
+
+func (f *Funcs) DisableCpuMemArena(options api.OrtSessionOptions) api.OrtStatus {
+	return f.disableCpuMemArena(options)
+}
+
+func (f *Funcs) SetDeterministicCompute(options api.OrtSessionOptions, value bool) api.OrtStatus {
+	return f.setDeterministicCompute(options, value)
+}
+
+func (f *Funcs) EnableProfiling(options api.OrtSessionOptions, profileFilePrefix *byte) api.OrtStatus {
+	return f.enableProfiling(options, profileFilePrefix)
+}
+
+func (f *Funcs) DisableProfiling(options api.OrtSessionOptions) api.OrtStatus {
+	return f.disableProfiling(options)
+}
+
+func (f *Funcs) AddSessionConfigEntry(options api.OrtSessionOptions, key *byte, value *byte) api.OrtStatus {
+	return f.addSessionConfigEntry(options, key, value)
+}
+
 func (f *Funcs) SessionOptionsAppendExecutionProvider(options api.OrtSessionOptions, providerName *byte, keys **byte, values **byte, numKeys uintptr) api.OrtStatus {
 	return f.sessionOptionsAppendExecutionProvider(options, providerName, keys, values, numKeys)
 }
@@ -224,16 +429,70 @@ func (f *Funcs) SessionGetOutputName(session api.OrtSession, index uintptr, allo
 	return f.sessionGetOutputName(session, index, allocator, name)
 }
 
-func (f *Funcs) Run(session api.OrtSession, runOptions uintptr, inputNames **byte, inputs *api.OrtValue, inputCount uintptr, outputNames **byte, outputCount uintptr, outputs *api.OrtValue) api.OrtStatus {
+func (f *Funcs) SessionGetInputTypeInfo(session api.OrtSession, index uintptr, typeInfo *api.OrtTypeInfo) api.OrtStatus {
+	return f.sessionGetInputTypeInfo(session, index, typeInfo)
+}
+
+func (f *Funcs) SessionGetOutputTypeInfo(session api.OrtSession, index uintptr, typeInfo *api.OrtTypeInfo) api.OrtStatus {
+	return f.sessionGetOutputTypeInfo(session, index, typeInfo)
+}
+
+func (f *Funcs) SessionEndProfiling(session api.OrtSession, allocator api.OrtAllocator, profileFile **byte) api.OrtStatus {
+	return f.sessionEndProfiling(session, allocator, profileFile)
+}
+
+func (f *Funcs) Run(session api.OrtSession, runOptions api.OrtRunOptions, inputNames **byte, inputs *api.OrtValue, inputCount uintptr, outputNames **byte, outputCount uintptr, outputs *api.OrtValue) api.OrtStatus {
 	return f.run(session, runOptions, inputNames, inputs, inputCount, outputNames, outputCount, outputs)
 }
 
+func (f *Funcs) RunAsync(session api.OrtSession, runOptions api.OrtRunOptions, inputNames **byte, inputs *api.OrtValue, inputCount uintptr, outputNames **byte, outputCount uintptr, outputs *api.OrtValue, callback uintptr, userData uintptr) api.OrtStatus {
+	return f.runAsync(session, runOptions, inputNames, inputs, inputCount, outputNames, outputCount, outputs, callback, userData)
+}
+
 func (f *Funcs) ReleaseSession(session api.OrtSession) {
 	f.releaseSession(session)
 }
 
+// Run options methods
+
+func (f *Funcs) CreateRunOptions(options *api.OrtRunOptions) api.OrtStatus {
+	return f.createRunOptions(options)
+}
+
+func (f *Funcs) RunOptionsSetRunTag(options api.OrtRunOptions, runTag *byte) api.OrtStatus {
+	return f.runOptionsSetRunTag(options, runTag)
+}
+
+func (f *Funcs) RunOptionsSetRunLogSeverityLevel(options api.OrtRunOptions, level int32) api.OrtStatus {
+	return f.runOptionsSetRunLogSeverityLevel(options, level)
+}
+
+func (f *Funcs) RunOptionsSetRunLogVerbosityLevel(options api.OrtRunOptions, level int32) api.OrtStatus {
+	return f.runOptionsSetRunLogVerbosityLevel(options, level)
+}
+
+func (f *Funcs) AddRunConfigEntry(options api.OrtRunOptions, key *byte, value *byte) api.OrtStatus {
+	return f.addRunConfigEntry(options, key, value)
+}
+
+func (f *Funcs) RunOptionsSetTerminate(options api.OrtRunOptions) api.OrtStatus {
+	return f.runOptionsSetTerminate(options)
+}
+
+func (f *Funcs) RunOptionsUnsetTerminate(options api.OrtRunOptions) api.OrtStatus {
+	return f.runOptionsUnsetTerminate(options)
+}
+
+func (f *Funcs) ReleaseRunOptions(options api.OrtRunOptions) {
+	f.releaseRunOptions(options)
+}
+
 // Tensor/Value operations methods
 
+func (f *Funcs) CreateTensorAsOrtValue(allocator api.OrtAllocator, shape *int64, shapeLen uintptr, dataType api.ONNXTensorElementDataType, value *api.OrtValue) api.OrtStatus {
+	return f.createTensorAsOrtValue(allocator, shape, shapeLen, dataType, value)
+}
+
 func (f *Funcs) CreateTensorWithDataAsOrtValue(memInfo api.OrtMemoryInfo, data unsafe.Pointer, dataSize uintptr, shape *int64, shapeLen uintptr, dataType api.ONNXTensorElementDataType, value *api.OrtValue) api.OrtStatus {
 	return f.createTensorWithDataAsOrtValue(memInfo, data, dataSize, shape, shapeLen, dataType, value)
 }
@@ -242,10 +501,38 @@ func (f *Funcs) GetValueType(value api.OrtValue, valueType *api.ONNXType) api.Or
 	return f.getValueType(value, valueType)
 }
 
+func (f *Funcs) GetValue(value api.OrtValue, index int32, allocator api.OrtAllocator, out *api.OrtValue) api.OrtStatus {
+	return f.getValue(value, index, allocator, out)
+}
+
+func (f *Funcs) GetValueCount(value api.OrtValue, count *uintptr) api.OrtStatus {
+	return f.getValueCount(value, count)
+}
+
+func (f *Funcs) CreateValue(in *api.OrtValue, numValues uintptr, valueType api.ONNXType, out *api.OrtValue) api.OrtStatus {
+	return f.createValue(in, numValues, valueType, out)
+}
+
+func (f *Funcs) HasValue(value api.OrtValue, out *int32) api.OrtStatus {
+	return f.hasValue(value, out)
+}
+
 func (f *Funcs) GetTensorMutableData(value api.OrtValue, data *unsafe.Pointer) api.OrtStatus {
 	return f.getTensorMutableData(value, data)
 }
 
+func (f *Funcs) FillStringTensor(value api.OrtValue, s **byte, sLen uintptr) api.OrtStatus {
+	return f.fillStringTensor(value, s, sLen)
+}
+
+func (f *Funcs) GetStringTensorDataLength(value api.OrtValue, length *uintptr) api.OrtStatus {
+	return f.getStringTensorDataLength(value, length)
+}
+
+func (f *Funcs) GetStringTensorContent(value api.OrtValue, s unsafe.Pointer, sLen uintptr, offsets *uintptr, offsetsLen uintptr) api.OrtStatus {
+	return f.getStringTensorContent(value, s, sLen, offsets, offsetsLen)
+}
+
 func (f *Funcs) GetTensorTypeAndShape(value api.OrtValue, typeAndShape *api.OrtTensorTypeAndShapeInfo) api.OrtStatus {
 	return f.getTensorTypeAndShape(value, typeAndShape)
 }
@@ -262,6 +549,10 @@ func (f *Funcs) GetDimensions(typeAndShape api.OrtTensorTypeAndShapeInfo, dims *
 	return f.getDimensions(typeAndShape, dims, dimsLen)
 }
 
+func (f *Funcs) GetSymbolicDimensions(typeAndShape api.OrtTensorTypeAndShapeInfo, dimParams **byte, dimParamsLen uintptr) api.OrtStatus {
+	return f.getSymbolicDimensions(typeAndShape, dimParams, dimParamsLen)
+}
+
 func (f *Funcs) GetTensorShapeElementCount(typeAndShape api.OrtTensorTypeAndShapeInfo, count *uintptr) api.OrtStatus {
 	return f.getTensorShapeElementCount(typeAndShape, count)
 }
@@ -274,6 +565,142 @@ func (f *Funcs) ReleaseTensorTypeAndShapeInfo(typeAndShape api.OrtTensorTypeAndS
 	f.releaseTensorTypeAndShapeInfo(typeAndShape)
 }
 
+// Type info methods
+
+func (f *Funcs) GetOnnxTypeFromTypeInfo(typeInfo api.OrtTypeInfo, onnxType *api.ONNXType) api.OrtStatus {
+	return f.getOnnxTypeFromTypeInfo(typeInfo, onnxType)
+}
+
+func (f *Funcs) CastTypeInfoToTensorInfo(typeInfo api.OrtTypeInfo, tensorInfo *api.OrtTensorTypeAndShapeInfo) api.OrtStatus {
+	return f.castTypeInfoToTensorInfo(typeInfo, tensorInfo)
+}
+
+func (f *Funcs) ReleaseTypeInfo(typeInfo api.OrtTypeInfo) {
+	f.releaseTypeInfo(typeInfo)
+}
+
+// Model metadata methods
+
+func (f *Funcs) SessionGetModelMetadata(session api.OrtSession, metadata *api.OrtModelMetadata) api.OrtStatus {
+	return f.sessionGetModelMetadata(session, metadata)
+}
+
+func (f *Funcs) ModelMetadataGetProducerName(metadata api.OrtModelMetadata, allocator api.OrtAllocator, value **byte) api.OrtStatus {
+	return f.modelMetadataGetProducerName(metadata, allocator, value)
+}
+
+func (f *Funcs) ModelMetadataGetGraphName(metadata api.OrtModelMetadata, allocator api.OrtAllocator, value **byte) api.OrtStatus {
+	return f.modelMetadataGetGraphName(metadata, allocator, value)
+}
+
+func (f *Funcs) ModelMetadataGetDomain(metadata api.OrtModelMetadata, allocator api.OrtAllocator, value **byte) api.OrtStatus {
+	return f.modelMetadataGetDomain(metadata, allocator, value)
+}
+
+func (f *Funcs) ModelMetadataGetDescription(metadata api.OrtModelMetadata, allocator api.OrtAllocator, value **byte) api.OrtStatus {
+	return f.modelMetadataGetDescription(metadata, allocator, value)
+}
+
+func (f *Funcs) ModelMetadataGetGraphDescription(metadata api.OrtModelMetadata, allocator api.OrtAllocator, value **byte) api.OrtStatus {
+	return f.modelMetadataGetGraphDescription(metadata, allocator, value)
+}
+
+func (f *Funcs) ModelMetadataGetVersion(metadata api.OrtModelMetadata, version *int64) api.OrtStatus {
+	return f.modelMetadataGetVersion(metadata, version)
+}
+
+func (f *Funcs) ModelMetadataGetCustomMetadataMapKeys(metadata api.OrtModelMetadata, allocator api.OrtAllocator, keys ***byte, numKeys *int64) api.OrtStatus {
+	return f.modelMetadataGetCustomMetadataMapKeys(metadata, allocator, keys, numKeys)
+}
+
+func (f *Funcs) ModelMetadataLookupCustomMetadataMap(metadata api.OrtModelMetadata, allocator api.OrtAllocator, key *byte, value **byte) api.OrtStatus {
+	return f.modelMetadataLookupCustomMetadataMap(metadata, allocator, key, value)
+}
+
+func (f *Funcs) ReleaseModelMetadata(metadata api.OrtModelMetadata) {
+	f.releaseModelMetadata(metadata)
+}
+
+// I/O binding methods
+
+func (f *Funcs) CreateIoBinding(session api.OrtSession, binding *api.OrtIoBinding) api.OrtStatus {
+	return f.createIoBinding(session, binding)
+}
+
+func (f *Funcs) BindInput(binding api.OrtIoBinding, name *byte, value api.OrtValue) api.OrtStatus {
+	return f.bindInput(binding, name, value)
+}
+
+func (f *Funcs) BindOutput(binding api.OrtIoBinding, name *byte, value api.OrtValue) api.OrtStatus {
+	return f.bindOutput(binding, name, value)
+}
+
+func (f *Funcs) BindOutputToDevice(binding api.OrtIoBinding, name *byte, memInfo api.OrtMemoryInfo) api.OrtStatus {
+	return f.bindOutputToDevice(binding, name, memInfo)
+}
+
+func (f *Funcs) GetBoundOutputNames(binding api.OrtIoBinding, allocator api.OrtAllocator, buffer **byte, lengths **uintptr, count *uintptr) api.OrtStatus {
+	return f.getBoundOutputNames(binding, allocator, buffer, lengths, count)
+}
+
+func (f *Funcs) GetBoundOutputValues(binding api.OrtIoBinding, allocator api.OrtAllocator, values **api.OrtValue, count *uintptr) api.OrtStatus {
+	return f.getBoundOutputValues(binding, allocator, values, count)
+}
+
+func (f *Funcs) ClearBoundInputs(binding api.OrtIoBinding) {
+	f.clearBoundInputs(binding)
+}
+
+func (f *Funcs) ClearBoundOutputs(binding api.OrtIoBinding) {
+	f.clearBoundOutputs(binding)
+}
+
+func (f *Funcs) RunWithBinding(session api.OrtSession, runOptions api.OrtRunOptions, binding api.OrtIoBinding) api.OrtStatus {
+	return f.runWithBinding(session, runOptions, binding)
+}
+
+func (f *Funcs) ReleaseIoBinding(binding api.OrtIoBinding) {
+	f.releaseIoBinding(binding)
+}
+
+// Sparse tensor methods
+
+func (f *Funcs) CreateSparseTensorWithValuesAsOrtValue(memInfo api.OrtMemoryInfo, values unsafe.Pointer, denseShape *int64, denseShapeLen uintptr, valuesShape *int64, valuesShapeLen uintptr, dataType api.ONNXTensorElementDataType, value *api.OrtValue) api.OrtStatus {
+	return f.createSparseTensorWithValuesAsOrtValue(memInfo, values, denseShape, denseShapeLen, valuesShape, valuesShapeLen, dataType, value)
+}
+
+func (f *Funcs) UseCooIndices(value api.OrtValue, indices *int64, indicesLen uintptr) api.OrtStatus {
+	return f.useCooIndices(value, indices, indicesLen)
+}
+
+func (f *Funcs) UseCsrIndices(value api.OrtValue, inner *int64, innerLen uintptr, outer *int64, outerLen uintptr) api.OrtStatus {
+	return f.useCsrIndices(value, inner, innerLen, outer, outerLen)
+}
+
+func (f *Funcs) UseBlockSparseIndices(value api.OrtValue, indicesShape *int64, indicesShapeLen uintptr, indices *int32) api.OrtStatus {
+	return f.useBlockSparseIndices(value, indicesShape, indicesShapeLen, indices)
+}
+
+func (f *Funcs) GetSparseTensorFormat(value api.OrtValue, format *api.OrtSparseFormat) api.OrtStatus {
+	return f.getSparseTensorFormat(value, format)
+}
+
+func (f *Funcs) GetSparseTensorValuesTypeAndShape(value api.OrtValue, info *api.OrtTensorTypeAndShapeInfo) api.OrtStatus {
+	return f.getSparseTensorValuesTypeAndShape(value, info)
+}
+
+func (f *Funcs) GetSparseTensorValues(value api.OrtValue, values *unsafe.Pointer) api.OrtStatus {
+	return f.getSparseTensorValues(value, values)
+}
+
+func (f *Funcs) GetSparseTensorIndicesTypeShape(value api.OrtValue, format api.OrtSparseIndicesFormat, info *api.OrtTensorTypeAndShapeInfo) api.OrtStatus {
+	return f.getSparseTensorIndicesTypeShape(value, format, info)
+}
+
+func (f *Funcs) GetSparseTensorIndices(value api.OrtValue, format api.OrtSparseIndicesFormat, count *uintptr, indices *unsafe.Pointer) api.OrtStatus {
+	return f.getSparseTensorIndices(value, format, count, indices)
+}
+
 // Execution provider information methods
 
 func (f *Funcs) GetAvailableProviders(providers ***byte, length *int32) api.OrtStatus {
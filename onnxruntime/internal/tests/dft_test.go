@@ -0,0 +1,177 @@
+package tests
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/shota3506/onnxruntime-purego/onnxruntime"
+)
+
+// TestDFTComplexRoundTrip runs the ONNX backend DFT test cases and checks that
+// their outputs round-trip through complex64 and complex128 tensors.
+// DFT represents complex numbers as a trailing dimension of size 2 holding the
+// real and imaginary parts, which matches the memory layout of complex64.
+// None of the backend test models take or return complex tensors, so the complex
+// tensors are only created and read back on the Go side and never fed to a model.
+func TestDFTComplexRoundTrip(t *testing.T) {
+	testCases, err := LoadTestCases(testDataDir)
+	if err != nil {
+		t.Fatalf("Failed to load test cases: %v", err)
+	}
+
+	var found bool
+	for _, tc := range testCases {
+		if !strings.HasPrefix(tc.Name, "test_dft") {
+			continue
+		}
+		found = true
+
+		t.Run(tc.Name, func(t *testing.T) {
+			env, err := testRuntime.NewEnv("test", onnxruntime.LoggingLevelWarning)
+			if err != nil {
+				t.Fatalf("Failed to create environment: %v", err)
+			}
+			defer env.Close()
+
+			modelData, err := os.ReadFile(tc.ModelPath)
+			if err != nil {
+				t.Fatalf("Failed to read model: %v", err)
+			}
+
+			session, err := testRuntime.NewSessionFromReader(env, bytes.NewReader(modelData), nil)
+			if err != nil {
+				if strings.Contains(err.Error(), "Unsupported model IR version") {
+					t.Skipf("Skipping due to unsupported IR version: %v", err)
+				}
+				t.Fatalf("Failed to create session: %v", err)
+			}
+			defer session.Close()
+
+			for _, dataSet := range tc.DataSets {
+				t.Run(fmt.Sprintf("dataset_%d", dataSet.ID), func(t *testing.T) {
+					runDFTComplexDataSet(t, testRuntime, session, dataSet)
+				})
+			}
+		})
+	}
+
+	if !found {
+		t.Skip("No DFT test cases found; run ./download_test_data.sh")
+	}
+}
+
+// runDFTComplexDataSet runs a DFT test data set and compares the output with the
+// expected values round-tripped through complex tensors
+func runDFTComplexDataSet(t *testing.T, runtime *onnxruntime.Runtime, session *onnxruntime.Session, dataSet TestDataSet) {
+	t.Helper()
+
+	if len(dataSet.Outputs) != 1 {
+		t.Fatalf("Expected 1 output file, got %d", len(dataSet.Outputs))
+	}
+
+	inputs := loadInputTensors(t, runtime, session, dataSet)
+
+	outputs, err := session.Run(t.Context(), inputs)
+	if err != nil {
+		t.Fatalf("Failed to run inference: %v", err)
+	}
+	defer func() {
+		for _, v := range outputs {
+			v.Close()
+		}
+	}()
+
+	output, ok := outputs[session.OutputNames()[0]]
+	if !ok {
+		t.Fatalf("Output %s not found in inference results", session.OutputNames()[0])
+	}
+	actualData, actualShape, err := onnxruntime.GetTensorData[float32](output)
+	if err != nil {
+		t.Fatalf("Failed to get actual output data: %v", err)
+	}
+
+	expectedData, expectedShape, err := LoadTestData(dataSet.Outputs[0].Path)
+	if err != nil {
+		t.Fatalf("Failed to load expected output: %v", err)
+	}
+	expectedFloats, ok := expectedData.([]float32)
+	if !ok {
+		t.Fatalf("Unexpected expected data type: %T", expectedData)
+	}
+
+	actual, actualComplexShape := toComplex64(t, actualData, actualShape)
+	expected, expectedComplexShape := toComplex64(t, expectedFloats, expectedShape)
+
+	// Round-trip the expected values through a complex64 tensor
+	tensor, err := onnxruntime.NewTensorValue(runtime, expected, expectedComplexShape)
+	if err != nil {
+		t.Fatalf("Failed to create complex64 tensor: %v", err)
+	}
+	defer tensor.Close()
+
+	elemType, err := tensor.GetTensorElementType()
+	if err != nil {
+		t.Fatalf("Failed to get element type: %v", err)
+	}
+	if elemType != onnxruntime.ONNXTensorElementDataTypeComplex64 {
+		t.Errorf("Expected complex64 type, got %d", elemType)
+	}
+
+	roundTripped, roundTrippedShape, err := onnxruntime.GetTensorData[complex64](tensor)
+	if err != nil {
+		t.Fatalf("Failed to get complex64 tensor data: %v", err)
+	}
+
+	compareComplex64Tensors(t, actual, actualComplexShape, roundTripped, roundTrippedShape)
+
+	// Round-trip the expected values through a complex128 tensor
+	expected128 := toComplex128(expected)
+	tensor128, err := onnxruntime.NewTensorValue(runtime, expected128, expectedComplexShape)
+	if err != nil {
+		t.Fatalf("Failed to create complex128 tensor: %v", err)
+	}
+	defer tensor128.Close()
+
+	roundTripped128, roundTrippedShape, err := onnxruntime.GetTensorData[complex128](tensor128)
+	if err != nil {
+		t.Fatalf("Failed to get complex128 tensor data: %v", err)
+	}
+
+	compareComplex128Tensors(t, toComplex128(actual), actualComplexShape, roundTripped128, roundTrippedShape)
+}
+
+// toComplex64 converts DFT float data with a trailing dimension of 2 into complex64 values
+func toComplex64(t *testing.T, data []float32, shape []int64) ([]complex64, []int64) {
+	t.Helper()
+
+	if len(shape) == 0 || shape[len(shape)-1] != 2 {
+		t.Fatalf("Expected trailing dimension of size 2, got shape %v", shape)
+	}
+
+	result := make([]complex64, len(data)/2)
+	for i := range result {
+		result[i] = complex(data[2*i], data[2*i+1])
+	}
+	return result, shape[:len(shape)-1]
+}
+
+// toComplex128 widens complex64 values to complex128
+func toComplex128(data []complex64) []complex128 {
+	result := make([]complex128, len(data))
+	for i, v := range data {
+		result[i] = complex128(v)
+	}
+	return result
+}
+
+func TestToComplex(t *testing.T) {
+	data := []float32{1, 2, -0.5, -1.5, 3, 0}
+	shape := []int64{3, 2}
+
+	actual, actualShape := toComplex64(t, data, shape)
+	compareComplex64Tensors(t, actual, actualShape, []complex64{1 + 2i, -0.5 - 1.5i, 3}, []int64{3})
+	compareComplex128Tensors(t, toComplex128(actual), actualShape, []complex128{1 + 2i, -0.5 - 1.5i, 3}, []int64{3})
+}
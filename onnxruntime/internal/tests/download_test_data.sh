@@ -120,6 +120,7 @@ PRIORITY_OPERATORS=(
     "test_flatten"
     "test_identity"
     "test_constant"
+    "test_dft"
 )
 
 # Copy selected operator tests to our test data directory
@@ -53,60 +53,9 @@ func TestE2E(t *testing.T) {
 func runTestDataSet(t *testing.T, runtime *onnxruntime.Runtime, session *onnxruntime.Session, dataSet TestDataSet) {
 	t.Helper()
 
-	inputNames := session.InputNames()
 	outputNames := session.OutputNames()
 
-	// Load input tensors
-	inputs := make(map[string]*onnxruntime.Value)
-	defer func() {
-		for _, v := range inputs {
-			v.Close()
-		}
-	}()
-
-	for i, inputData := range dataSet.Inputs {
-		if i >= len(inputNames) {
-			t.Fatalf("More input files than expected inputs")
-		}
-
-		data, shape, err := LoadTestData(inputData.Path)
-		if err != nil {
-			// Skip test cases with unsupported data types
-			if strings.Contains(err.Error(), "unsupported data type") {
-				t.Skipf("Skipping due to unsupported data type: %v", err)
-			}
-			t.Fatalf("Failed to load input %s: %v", inputData.Name, err)
-		}
-
-		var tensor *onnxruntime.Value
-		switch v := data.(type) {
-		case []float32:
-			tensor, err = onnxruntime.NewTensorValue(runtime, v, shape)
-		case []int64:
-			tensor, err = onnxruntime.NewTensorValue(runtime, v, shape)
-		case []int32:
-			tensor, err = onnxruntime.NewTensorValue(runtime, v, shape)
-		case []int16:
-			tensor, err = onnxruntime.NewTensorValue(runtime, v, shape)
-		case []int8:
-			tensor, err = onnxruntime.NewTensorValue(runtime, v, shape)
-		case []uint8:
-			tensor, err = onnxruntime.NewTensorValue(runtime, v, shape)
-		case []uint16:
-			tensor, err = onnxruntime.NewTensorValue(runtime, v, shape)
-		case []uint32:
-			tensor, err = onnxruntime.NewTensorValue(runtime, v, shape)
-		case []uint64:
-			tensor, err = onnxruntime.NewTensorValue(runtime, v, shape)
-		default:
-			t.Fatalf("Unsupported data type: %T", data)
-		}
-		if err != nil {
-			t.Fatalf("Failed to create tensor: %v", err)
-		}
-
-		inputs[inputNames[i]] = tensor
-	}
+	inputs := loadInputTensors(t, runtime, session, dataSet)
 
 	outputs, err := session.Run(t.Context(), inputs)
 	if err != nil {
@@ -203,8 +152,88 @@ func runTestDataSet(t *testing.T, runtime *onnxruntime.Runtime, session *onnxrun
 			}
 			compareUint64Tensors(t, actual, actualShape, expected, expectedShape)
 
+		case []complex64:
+			actual, actualShape, err := onnxruntime.GetTensorData[complex64](actualOutput)
+			if err != nil {
+				t.Fatalf("Failed to get actual output data: %v", err)
+			}
+			compareComplex64Tensors(t, actual, actualShape, expected, expectedShape)
+
+		case []complex128:
+			actual, actualShape, err := onnxruntime.GetTensorData[complex128](actualOutput)
+			if err != nil {
+				t.Fatalf("Failed to get actual output data: %v", err)
+			}
+			compareComplex128Tensors(t, actual, actualShape, expected, expectedShape)
+
 		default:
 			t.Fatalf("Unsupported expected data type: %T", expected)
 		}
 	}
 }
+
+// loadInputTensors loads the input tensors of a test data set keyed by session input name.
+// The tensors are closed when the test finishes.
+func loadInputTensors(t *testing.T, runtime *onnxruntime.Runtime, session *onnxruntime.Session, dataSet TestDataSet) map[string]*onnxruntime.Value {
+	t.Helper()
+
+	inputNames := session.InputNames()
+
+	// Load input tensors
+	inputs := make(map[string]*onnxruntime.Value)
+	t.Cleanup(func() {
+		for _, v := range inputs {
+			v.Close()
+		}
+	})
+
+	for i, inputData := range dataSet.Inputs {
+		if i >= len(inputNames) {
+			t.Fatalf("More input files than expected inputs")
+		}
+
+		data, shape, err := LoadTestData(inputData.Path)
+		if err != nil {
+			// Skip test cases with unsupported data types
+			if strings.Contains(err.Error(), "unsupported data type") {
+				t.Skipf("Skipping due to unsupported data type: %v", err)
+			}
+			t.Fatalf("Failed to load input %s: %v", inputData.Name, err)
+		}
+
+		var tensor *onnxruntime.Value
+		switch v := data.(type) {
+		case []float32:
+			tensor, err = onnxruntime.NewTensorValue(runtime, v, shape)
+		case []int64:
+			tensor, err = onnxruntime.NewTensorValue(runtime, v, shape)
+		case []int32:
+			tensor, err = onnxruntime.NewTensorValue(runtime, v, shape)
+		case []int16:
+			tensor, err = onnxruntime.NewTensorValue(runtime, v, shape)
+		case []int8:
+			tensor, err = onnxruntime.NewTensorValue(runtime, v, shape)
+		case []uint8:
+			tensor, err = onnxruntime.NewTensorValue(runtime, v, shape)
+		case []uint16:
+			tensor, err = onnxruntime.NewTensorValue(runtime, v, shape)
+		case []uint32:
+			tensor, err = onnxruntime.NewTensorValue(runtime, v, shape)
+		case []uint64:
+			tensor, err = onnxruntime.NewTensorValue(runtime, v, shape)
+		case []complex64:
+			tensor, err = onnxruntime.NewTensorValue(runtime, v, shape)
+		case []complex128:
+			tensor, err = onnxruntime.NewTensorValue(runtime, v, shape)
+		default:
+			t.Fatalf("Unsupported data type: %T", data)
+		}
+		if err != nil {
+			t.Fatalf("Failed to create tensor: %v", err)
+		}
+
+		inputs[inputNames[i]] = tensor
+	}
+
+	return inputs
+}
@@ -3,6 +3,7 @@ package tests
 import (
 	"fmt"
 	"math"
+	"math/cmplx"
 	"os"
 	"path/filepath"
 	"runtime"
@@ -203,3 +204,54 @@ func compareIntegerTensors(t *testing.T, actualLen, expectedLen int, actualShape
 		t.Errorf("Total %d values mismatched", errorCount)
 	}
 }
+
+// compareComplex64Tensors compares two complex64 tensors with tolerance
+func compareComplex64Tensors(t *testing.T, actual []complex64, actualShape []int64, expected []complex64, expectedShape []int64) {
+	compareComplexTensors(t, len(actual), len(expected), actualShape, expectedShape, func(i int) (complex128, complex128) {
+		return complex128(actual[i]), complex128(expected[i])
+	})
+}
+
+// compareComplex128Tensors compares two complex128 tensors with tolerance
+func compareComplex128Tensors(t *testing.T, actual []complex128, actualShape []int64, expected []complex128, expectedShape []int64) {
+	compareComplexTensors(t, len(actual), len(expected), actualShape, expectedShape, func(i int) (complex128, complex128) {
+		return actual[i], expected[i]
+	})
+}
+
+// compareComplexTensors is a generic helper for complex comparison.
+// The error is measured as the magnitude of the difference between values.
+func compareComplexTensors(t *testing.T, actualLen, expectedLen int, actualShape, expectedShape []int64, getValue func(int) (complex128, complex128)) {
+	t.Helper()
+
+	if !slices.Equal(actualShape, expectedShape) {
+		t.Errorf("Shape mismatch: actual %v vs expected %v", actualShape, expectedShape)
+		return
+	}
+	if actualLen != expectedLen {
+		t.Errorf("Length mismatch: actual %d vs expected %d", actualLen, expectedLen)
+		return
+	}
+
+	errorCount := 0
+	for i := range actualLen {
+		actualVal, expectedVal := getValue(i)
+		absError := cmplx.Abs(actualVal - expectedVal)
+		relError := 0.0
+		if expectedVal != 0 {
+			relError = absError / cmplx.Abs(expectedVal)
+		}
+
+		if absError > defaultAbsoluteTolerance && relError > defaultRelativeTolerance {
+			if errorCount < 10 {
+				t.Errorf("Value mismatch at index %d: actual %v vs expected %v (abs_err=%.2e, rel_err=%.2e)",
+					i, actualVal, expectedVal, absError, relError)
+			}
+			errorCount++
+		}
+	}
+
+	if errorCount > 0 {
+		t.Errorf("Total %d values exceeded tolerance", errorCount)
+	}
+}
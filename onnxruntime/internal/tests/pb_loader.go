@@ -282,6 +282,50 @@ func (t *TensorProto) ToUint64() ([]uint64, error) {
 	return result, nil
 }
 
+// ToComplex64 converts tensor data to complex64 slice
+func (t *TensorProto) ToComplex64() ([]complex64, error) {
+	if t.DataType != 14 { // ONNX COMPLEX64 = 14
+		return nil, fmt.Errorf("tensor is not complex64 type (got type %d)", t.DataType)
+	}
+
+	if len(t.RawData)%8 != 0 {
+		return nil, fmt.Errorf("invalid raw_data length for complex64")
+	}
+
+	count := len(t.RawData) / 8
+	result := make([]complex64, count)
+
+	for i := range count {
+		re := math.Float32frombits(binary.LittleEndian.Uint32(t.RawData[i*8 : i*8+4]))
+		im := math.Float32frombits(binary.LittleEndian.Uint32(t.RawData[i*8+4 : (i+1)*8]))
+		result[i] = complex(re, im)
+	}
+
+	return result, nil
+}
+
+// ToComplex128 converts tensor data to complex128 slice
+func (t *TensorProto) ToComplex128() ([]complex128, error) {
+	if t.DataType != 15 { // ONNX COMPLEX128 = 15
+		return nil, fmt.Errorf("tensor is not complex128 type (got type %d)", t.DataType)
+	}
+
+	if len(t.RawData)%16 != 0 {
+		return nil, fmt.Errorf("invalid raw_data length for complex128")
+	}
+
+	count := len(t.RawData) / 16
+	result := make([]complex128, count)
+
+	for i := range count {
+		re := math.Float64frombits(binary.LittleEndian.Uint64(t.RawData[i*16 : i*16+8]))
+		im := math.Float64frombits(binary.LittleEndian.Uint64(t.RawData[i*16+8 : (i+1)*16]))
+		result[i] = complex(re, im)
+	}
+
+	return result, nil
+}
+
 // LoadTestData is a helper function to load and parse test input/output data
 func LoadTestData(path string) (any, []int64, error) {
 	tensor, err := LoadTensorProto(path)
@@ -309,6 +353,10 @@ func LoadTestData(path string) (any, []int64, error) {
 		data, err = tensor.ToUint32()
 	case 13: // UINT64
 		data, err = tensor.ToUint64()
+	case 14: // COMPLEX64
+		data, err = tensor.ToComplex64()
+	case 15: // COMPLEX128
+		data, err = tensor.ToComplex128()
 	default:
 		return nil, nil, fmt.Errorf("unsupported data type: %d", tensor.DataType)
 	}
@@ -0,0 +1,294 @@
+//go:build ignore
+
+// gen_models generates the small ONNX models used by the unit tests that
+// cannot be expressed with model.onnx. The protobuf messages are encoded by
+// hand to avoid depending on a protobuf library.
+//
+// Usage (from this directory):
+//
+//	go run gen_models.go
+package main
+
+import (
+	"encoding/binary"
+	"log"
+	"os"
+)
+
+// ONNX tensor element types.
+const (
+	elemFloat      = 1
+	elemInt64      = 7
+	elemBool       = 9
+	elemComplex64  = 14
+	elemComplex128 = 15
+)
+
+// AttributeProto.AttributeType values.
+const (
+	attributeTypeGraph     = 5
+	attributeTypeTypeProto = 13
+)
+
+// message is a protobuf message under construction.
+type message []byte
+
+func (m message) varint(field int, v uint64) message {
+	m = binary.AppendUvarint(m, uint64(field)<<3)
+	return binary.AppendUvarint(m, v)
+}
+
+func (m message) bytes(field int, b []byte) message {
+	m = binary.AppendUvarint(m, uint64(field)<<3|2)
+	m = binary.AppendUvarint(m, uint64(len(b)))
+	return append(m, b...)
+}
+
+func (m message) str(field int, s string) message {
+	return m.bytes(field, []byte(s))
+}
+
+// tensorType builds a TypeProto for a tensor. A nil shape leaves the shape unknown.
+func tensorType(elemType int, shape []int64) message {
+	tensor := message{}.varint(1, uint64(elemType))
+	if shape != nil {
+		shapeProto := message{}
+		for _, dim := range shape {
+			shapeProto = shapeProto.bytes(1, message{}.varint(1, uint64(dim)))
+		}
+		tensor = tensor.bytes(2, shapeProto)
+	}
+	return message{}.bytes(1, tensor)
+}
+
+// optionalType builds a TypeProto for an optional of elemType.
+func optionalType(elemType message) message {
+	return message{}.bytes(9, message{}.bytes(1, elemType))
+}
+
+func valueInfo(name string, typ message) message {
+	return message{}.str(1, name).bytes(2, typ)
+}
+
+func node(opType string, inputs, outputs []string, attributes ...message) message {
+	m := message{}
+	for _, input := range inputs {
+		m = m.str(1, input)
+	}
+	for _, output := range outputs {
+		m = m.str(2, output)
+	}
+	m = m.str(4, opType)
+	for _, attribute := range attributes {
+		m = m.bytes(5, attribute)
+	}
+	return m
+}
+
+func graphAttribute(name string, graph message) message {
+	return message{}.str(1, name).bytes(6, graph).varint(20, attributeTypeGraph)
+}
+
+func typeAttribute(name string, typ message) message {
+	return message{}.str(1, name).bytes(14, typ).varint(20, attributeTypeTypeProto)
+}
+
+// scalarInitializer builds a rank-0 TensorProto with raw little-endian data.
+func scalarInitializer(name string, elemType int, raw []byte) message {
+	return message{}.varint(2, uint64(elemType)).str(8, name).bytes(9, raw)
+}
+
+type graphDef struct {
+	name         string
+	docString    string
+	nodes        []message
+	initializers []message
+	inputs       []message
+	outputs      []message
+}
+
+func (g graphDef) encode() message {
+	m := message{}
+	for _, n := range g.nodes {
+		m = m.bytes(1, n)
+	}
+	m = m.str(2, g.name)
+	for _, initializer := range g.initializers {
+		m = m.bytes(5, initializer)
+	}
+	for _, input := range g.inputs {
+		m = m.bytes(11, input)
+	}
+	for _, output := range g.outputs {
+		m = m.bytes(12, output)
+	}
+	if g.docString != "" {
+		m = m.str(10, g.docString)
+	}
+	return m
+}
+
+// modelDef holds the fields of a ModelProto besides the graph.
+type modelDef struct {
+	domain    string
+	version   int64
+	docString string
+	// metadata holds key/value pairs of metadata_props, in order.
+	metadata [][2]string
+}
+
+func model(graph graphDef) []byte {
+	return modelDef{}.encode(graph)
+}
+
+func (d modelDef) encode(graph graphDef) []byte {
+	opset := message{}.str(1, "").varint(2, 18)
+	m := message{}.
+		varint(1, 8). // IR version
+		str(2, "onnxruntime-purego")
+	if d.domain != "" {
+		m = m.str(4, d.domain)
+	}
+	if d.version != 0 {
+		m = m.varint(5, uint64(d.version))
+	}
+	if d.docString != "" {
+		m = m.str(6, d.docString)
+	}
+	m = m.bytes(7, graph.encode()).bytes(8, opset)
+	for _, kv := range d.metadata {
+		m = m.bytes(14, message{}.str(1, kv[0]).str(2, kv[1]))
+	}
+	return m
+}
+
+// optionalModel returns whether its optional tensor input has an element.
+func optionalModel() []byte {
+	return model(graphDef{
+		name:  "optional",
+		nodes: []message{node("OptionalHasElement", []string{"x"}, []string{"has_element"})},
+		inputs: []message{
+			valueInfo("x", optionalType(tensorType(elemFloat, []int64{3}))),
+		},
+		outputs: []message{
+			valueInfo("has_element", tensorType(elemBool, []int64{})),
+		},
+	})
+}
+
+// optionalOutputModel returns its input wrapped in an optional as "some", and
+// an optional without an element as "none".
+func optionalOutputModel() []byte {
+	element := tensorType(elemFloat, []int64{3})
+	return model(graphDef{
+		name: "optional_output",
+		nodes: []message{
+			node("Optional", []string{"x"}, []string{"some"}),
+			node("Optional", nil, []string{"none"}, typeAttribute("type", element)),
+		},
+		inputs: []message{
+			valueInfo("x", element),
+		},
+		outputs: []message{
+			valueInfo("some", optionalType(element)),
+			valueInfo("none", optionalType(element)),
+		},
+	})
+}
+
+// complexModel passes complex64 and complex128 tensors through Identity nodes.
+func complexModel() []byte {
+	return model(graphDef{
+		name: "complex",
+		nodes: []message{
+			node("Identity", []string{"x64"}, []string{"y64"}),
+			node("Identity", []string{"x128"}, []string{"y128"}),
+		},
+		inputs: []message{
+			valueInfo("x64", tensorType(elemComplex64, []int64{3})),
+			valueInfo("x128", tensorType(elemComplex128, []int64{3})),
+		},
+		outputs: []message{
+			valueInfo("y64", tensorType(elemComplex64, []int64{3})),
+			valueInfo("y128", tensorType(elemComplex128, []int64{3})),
+		},
+	})
+}
+
+// loopModel runs a loop that practically never terminates on its own,
+// which allows cancelling a run while it is in flight.
+func loopModel() []byte {
+	body := graphDef{
+		name: "body",
+		nodes: []message{
+			node("Identity", []string{"cond_in"}, []string{"cond_out"}),
+			node("Add", []string{"v_in", "v_in"}, []string{"v_out"}),
+		},
+		inputs: []message{
+			valueInfo("i", tensorType(elemInt64, []int64{})),
+			valueInfo("cond_in", tensorType(elemBool, []int64{})),
+			valueInfo("v_in", tensorType(elemFloat, []int64{1})),
+		},
+		outputs: []message{
+			valueInfo("cond_out", tensorType(elemBool, []int64{})),
+			valueInfo("v_out", tensorType(elemFloat, []int64{1})),
+		},
+	}
+
+	return model(graphDef{
+		name: "loop",
+		nodes: []message{
+			node("Loop", []string{"max_trip_count", "cond", "x"}, []string{"y"}, graphAttribute("body", body.encode())),
+		},
+		initializers: []message{
+			scalarInitializer("max_trip_count", elemInt64, binary.LittleEndian.AppendUint64(nil, 1<<62)),
+			scalarInitializer("cond", elemBool, []byte{1}),
+		},
+		inputs: []message{
+			valueInfo("x", tensorType(elemFloat, []int64{1})),
+		},
+		outputs: []message{
+			valueInfo("y", tensorType(elemFloat, []int64{1})),
+		},
+	})
+}
+
+// metadataModel passes its input through and carries model metadata,
+// including custom metadata_props.
+func metadataModel() []byte {
+	return modelDef{
+		domain:    "ai.onnxruntime-purego.test",
+		version:   7,
+		docString: "Model with metadata",
+		metadata: [][2]string{
+			{"labels", "cat,dog,bird"},
+			{"author", "onnxruntime-purego"},
+			{"empty", ""},
+		},
+	}.encode(graphDef{
+		name:      "metadata",
+		docString: "Identity graph",
+		nodes:     []message{node("Identity", []string{"x"}, []string{"y"})},
+		inputs: []message{
+			valueInfo("x", tensorType(elemFloat, []int64{1})),
+		},
+		outputs: []message{
+			valueInfo("y", tensorType(elemFloat, []int64{1})),
+		},
+	})
+}
+
+func main() {
+	models := map[string][]byte{
+		"optional.onnx":        optionalModel(),
+		"optional_output.onnx": optionalOutputModel(),
+		"loop.onnx":            loopModel(),
+		"metadata.onnx":        metadataModel(),
+		"complex.onnx":         complexModel(),
+	}
+	for name, data := range models {
+		if err := os.WriteFile(name, data, 0o644); err != nil {
+			log.Fatalf("failed to write %s: %v", name, err)
+		}
+	}
+}
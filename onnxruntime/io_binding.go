@@ -0,0 +1,293 @@
+package onnxruntime
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"unsafe"
+
+	"github.com/shota3506/onnxruntime-purego/onnxruntime/internal/api"
+)
+
+// IoBinding binds the inputs and outputs of a session ahead of time, so that
+// repeated runs with the same inputs and outputs avoid marshalling names and
+// allocating outputs on every call.
+// An IoBinding must be closed before its session is closed.
+type IoBinding struct {
+	ptr     api.OrtIoBinding
+	session *Session
+
+	// inputs and outputs hold references to the Go memory of the bound values,
+	// keyed by name. ONNX Runtime keeps the native buffers of bound values, so
+	// the memory stays pinned while they are bound, even if the values are closed.
+	inputs  map[string][]*pinnedData
+	outputs map[string][]*pinnedData
+}
+
+// NewIoBinding creates a new I/O binding for the session.
+func (s *Session) NewIoBinding() (*IoBinding, error) {
+	if s.ptr == 0 {
+		return nil, ErrSessionClosed
+	}
+
+	var ptr api.OrtIoBinding
+	status := s.runtime.apiFuncs.CreateIoBinding(s.ptr, &ptr)
+	if err := s.runtime.statusError(status, "CreateIoBinding"); err != nil {
+		return nil, fmt.Errorf("failed to create io binding: %w", err)
+	}
+
+	return &IoBinding{
+		ptr:     ptr,
+		session: s,
+		inputs:  make(map[string][]*pinnedData),
+		outputs: make(map[string][]*pinnedData),
+	}, nil
+}
+
+// BindInput binds a value to the input with the given name.
+// Changes to its data are seen by subsequent runs.
+func (b *IoBinding) BindInput(name string, value *Value) error {
+	if b.ptr == 0 {
+		return ErrIoBindingClosed
+	}
+	info, ok := b.session.inputInfoByName(name)
+	if !ok {
+		return &UnknownInputError{Name: name}
+	}
+	if err := validateInput(info, value); err != nil {
+		return err
+	}
+
+	r := b.session.runtime
+	nameBytes := append([]byte(name), 0)
+	status := r.apiFuncs.BindInput(b.ptr, &nameBytes[0], value.ptr)
+	if err := r.statusError(status, "BindInput"); err != nil {
+		return fmt.Errorf("failed to bind input %q: %w", name, err)
+	}
+	bindData(b.inputs, name, value)
+	return nil
+}
+
+// BindOutput binds a preallocated value to the output with the given name.
+// Runs write the output directly into the value.
+func (b *IoBinding) BindOutput(name string, value *Value) error {
+	if b.ptr == 0 {
+		return ErrIoBindingClosed
+	}
+	if !slices.Contains(b.session.outputNames, name) {
+		return &UnknownOutputError{Name: name}
+	}
+	if err := value.checkOpen(); err != nil {
+		return err
+	}
+
+	r := b.session.runtime
+	nameBytes := append([]byte(name), 0)
+	status := r.apiFuncs.BindOutput(b.ptr, &nameBytes[0], value.ptr)
+	if err := r.statusError(status, "BindOutput"); err != nil {
+		return fmt.Errorf("failed to bind output %q: %w", name, err)
+	}
+	bindData(b.outputs, name, value)
+	return nil
+}
+
+// BindOutputToCPU binds the output with the given name to CPU memory described
+// by the runtime's default memory info. ONNX Runtime allocates the output on
+// each run; use Outputs to retrieve it. This is useful when the output shape is
+// not known in advance.
+func (b *IoBinding) BindOutputToCPU(name string) error {
+	if b.session.runtime.cpuMemoryInfo == nil {
+		return fmt.Errorf("default memory info not initialized")
+	}
+	return b.BindOutputToDevice(name, b.session.runtime.cpuMemoryInfo)
+}
+
+// BindOutputToDevice binds the output with the given name to the memory
+// described by info, such as the memory of a device used by an execution
+// provider. ONNX Runtime allocates the output on each run; use Outputs to
+// retrieve it.
+func (b *IoBinding) BindOutputToDevice(name string, info *MemoryInfo) error {
+	if b.ptr == 0 {
+		return ErrIoBindingClosed
+	}
+	if !slices.Contains(b.session.outputNames, name) {
+		return &UnknownOutputError{Name: name}
+	}
+	if info == nil || info.ptr == 0 {
+		return fmt.Errorf("memory info is nil or closed")
+	}
+
+	r := b.session.runtime
+	nameBytes := append([]byte(name), 0)
+	status := r.apiFuncs.BindOutputToDevice(b.ptr, &nameBytes[0], info.ptr)
+	if err := r.statusError(status, "BindOutputToDevice"); err != nil {
+		return fmt.Errorf("failed to bind output %q: %w", name, err)
+	}
+	unbindData(b.outputs, name)
+	return nil
+}
+
+// Run executes the model with the bound inputs and outputs.
+// WithOutputNames has no effect; the bound outputs are computed instead.
+//
+// If ctx is cancelled or its deadline expires while the model is running, the
+// inference is terminated and the returned error wraps both ctx.Err() and the
+// RuntimeError reported by ONNX Runtime.
+func (b *IoBinding) Run(ctx context.Context, opts ...RunOption) error {
+	if b.ptr == 0 {
+		return ErrIoBindingClosed
+	}
+	s := b.session
+	if s.ptr == 0 {
+		return ErrSessionClosed
+	}
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	config := &runConfig{}
+	for _, opt := range opts {
+		opt(config)
+	}
+
+	runOptionsPtr, releaseRunOptions, err := s.runtime.prepareRunOptions(ctx, config)
+	if err != nil {
+		return err
+	}
+	defer releaseRunOptions()
+
+	status := s.runtime.apiFuncs.RunWithBinding(s.ptr, runOptionsPtr, b.ptr)
+	if err := s.runtime.statusError(status, "RunWithBinding"); err != nil {
+		err = fmt.Errorf("failed to run inference: %w", err)
+		if ctxErr := ctx.Err(); ctxErr != nil {
+			return fmt.Errorf("%w: %w", ctxErr, err)
+		}
+		return err
+	}
+	return nil
+}
+
+// Outputs returns the bound outputs after Run, keyed by output name.
+// The returned values are new values sharing the output data with the binding;
+// the caller must close them.
+func (b *IoBinding) Outputs() (map[string]*Value, error) {
+	if b.ptr == 0 {
+		return nil, ErrIoBindingClosed
+	}
+
+	names, err := b.getBoundOutputNames()
+	if err != nil {
+		return nil, err
+	}
+	if len(names) == 0 {
+		return map[string]*Value{}, nil
+	}
+
+	r := b.session.runtime
+	var valuesPtr *api.OrtValue
+	var count uintptr
+	status := r.apiFuncs.GetBoundOutputValues(b.ptr, r.allocator.ptr, &valuesPtr, &count)
+	if err := r.statusError(status, "GetBoundOutputValues"); err != nil {
+		return nil, fmt.Errorf("failed to get bound output values: %w", err)
+	}
+	defer r.allocator.free(unsafe.Pointer(valuesPtr))
+
+	if int(count) != len(names) {
+		for _, ptr := range unsafe.Slice(valuesPtr, count) {
+			r.apiFuncs.ReleaseValue(ptr)
+		}
+		return nil, fmt.Errorf("bound output count mismatch: %d names, %d values", len(names), count)
+	}
+
+	outputs := make(map[string]*Value, count)
+	for i, ptr := range unsafe.Slice(valuesPtr, count) {
+		outputs[names[i]] = r.newValueFromPtr(ptr)
+	}
+	return outputs, nil
+}
+
+// getBoundOutputNames returns the names of the bound outputs in binding order.
+func (b *IoBinding) getBoundOutputNames() ([]string, error) {
+	r := b.session.runtime
+	var buffer *byte
+	var lengthsPtr *uintptr
+	var count uintptr
+	status := r.apiFuncs.GetBoundOutputNames(b.ptr, r.allocator.ptr, &buffer, &lengthsPtr, &count)
+	if err := r.statusError(status, "GetBoundOutputNames"); err != nil {
+		return nil, fmt.Errorf("failed to get bound output names: %w", err)
+	}
+	if count == 0 {
+		return nil, nil
+	}
+	defer r.allocator.free(unsafe.Pointer(buffer))
+	defer r.allocator.free(unsafe.Pointer(lengthsPtr))
+
+	// The buffer holds all names concatenated without terminators.
+	lengths := unsafe.Slice(lengthsPtr, count)
+	var total uintptr
+	for _, length := range lengths {
+		total += length
+	}
+	content := unsafe.Slice(buffer, total)
+
+	names := make([]string, count)
+	var offset uintptr
+	for i, length := range lengths {
+		names[i] = string(content[offset : offset+length])
+		offset += length
+	}
+	return names, nil
+}
+
+// ClearBoundInputs removes all bound inputs.
+func (b *IoBinding) ClearBoundInputs() {
+	if b.ptr == 0 {
+		return
+	}
+	b.session.runtime.apiFuncs.ClearBoundInputs(b.ptr)
+	unbindAllData(b.inputs)
+}
+
+// ClearBoundOutputs removes all bound outputs.
+func (b *IoBinding) ClearBoundOutputs() {
+	if b.ptr == 0 {
+		return
+	}
+	b.session.runtime.apiFuncs.ClearBoundOutputs(b.ptr)
+	unbindAllData(b.outputs)
+}
+
+// Close releases the I/O binding. It does not close the bound values.
+// It is safe to call Close multiple times.
+func (b *IoBinding) Close() {
+	if b.ptr != 0 && b.session.runtime.apiFuncs != nil {
+		b.session.runtime.apiFuncs.ReleaseIoBinding(b.ptr)
+		b.ptr = 0
+	}
+	unbindAllData(b.inputs)
+	unbindAllData(b.outputs)
+}
+
+// bindData makes bound reference the Go memory of value bound to name,
+// replacing the references of the value previously bound to name.
+func bindData(bound map[string][]*pinnedData, name string, value *Value) {
+	unbindData(bound, name)
+	for _, p := range value.pinned {
+		bound[name] = append(bound[name], p.acquire())
+	}
+}
+
+// unbindData drops the references to the Go memory of the value bound to name.
+func unbindData(bound map[string][]*pinnedData, name string) {
+	for _, p := range bound[name] {
+		p.release()
+	}
+	delete(bound, name)
+}
+
+// unbindAllData drops the references to the Go memory of all bound values.
+func unbindAllData(bound map[string][]*pinnedData) {
+	for name := range bound {
+		unbindData(bound, name)
+	}
+}
@@ -0,0 +1,287 @@
+package onnxruntime
+
+import (
+	"errors"
+	"maps"
+	"slices"
+	"testing"
+)
+
+func TestIoBinding(t *testing.T) {
+	runtime := newTestRuntime(t)
+	session := newTestSession(t, runtime)
+
+	inputTensor, err := NewTensorValue(runtime, []float32{1, 2, 3, 4, 5, 6, 7, 8, 9, 10}, []int64{1, 10})
+	if err != nil {
+		t.Fatalf("Failed to create input tensor: %v", err)
+	}
+	defer inputTensor.Close()
+
+	// Reference output from a regular run
+	outputs, err := session.Run(t.Context(), map[string]*Value{"input": inputTensor})
+	if err != nil {
+		t.Fatalf("Failed to run inference: %v", err)
+	}
+	expected, _, err := GetTensorData[float32](outputs["logits"])
+	if err != nil {
+		t.Fatalf("Failed to get output data: %v", err)
+	}
+	outputs["logits"].Close()
+
+	t.Run("PreallocatedOutput", func(t *testing.T) {
+		binding, err := session.NewIoBinding()
+		if err != nil {
+			t.Fatalf("Failed to create io binding: %v", err)
+		}
+		defer binding.Close()
+
+		outputTensor, err := NewEmptyTensor[float32](runtime, []int64{1, 3})
+		if err != nil {
+			t.Fatalf("Failed to create output tensor: %v", err)
+		}
+		defer outputTensor.Close()
+
+		if err := binding.BindInput("input", inputTensor); err != nil {
+			t.Fatalf("Failed to bind input: %v", err)
+		}
+		if err := binding.BindOutput("logits", outputTensor); err != nil {
+			t.Fatalf("Failed to bind output: %v", err)
+		}
+
+		for range 3 {
+			if err := binding.Run(t.Context()); err != nil {
+				t.Fatalf("Failed to run with binding: %v", err)
+			}
+			assertTensorData(t, outputTensor, expected, []int64{1, 3})
+		}
+	})
+
+	t.Run("OutputToCPU", func(t *testing.T) {
+		binding, err := session.NewIoBinding()
+		if err != nil {
+			t.Fatalf("Failed to create io binding: %v", err)
+		}
+		defer binding.Close()
+
+		if err := binding.BindInput("input", inputTensor); err != nil {
+			t.Fatalf("Failed to bind input: %v", err)
+		}
+		if err := binding.BindOutputToCPU("logits"); err != nil {
+			t.Fatalf("Failed to bind output: %v", err)
+		}
+		if err := binding.Run(t.Context()); err != nil {
+			t.Fatalf("Failed to run with binding: %v", err)
+		}
+
+		boundOutputs, err := binding.Outputs()
+		if err != nil {
+			t.Fatalf("Failed to get bound outputs: %v", err)
+		}
+		defer func() {
+			for _, v := range boundOutputs {
+				v.Close()
+			}
+		}()
+
+		names := slices.Collect(maps.Keys(boundOutputs))
+		if !slices.Equal(names, []string{"logits"}) {
+			t.Fatalf("Expected bound output logits, got %v", names)
+		}
+		assertTensorData(t, boundOutputs["logits"], expected, []int64{1, 3})
+	})
+
+	t.Run("OutputToDevice", func(t *testing.T) {
+		memoryInfo, err := runtime.NewMemoryInfo("Cpu", 0)
+		if err != nil {
+			t.Fatalf("Failed to create memory info: %v", err)
+		}
+		defer memoryInfo.Close()
+
+		binding, err := session.NewIoBinding()
+		if err != nil {
+			t.Fatalf("Failed to create io binding: %v", err)
+		}
+		defer binding.Close()
+
+		if err := binding.BindInput("input", inputTensor); err != nil {
+			t.Fatalf("Failed to bind input: %v", err)
+		}
+		if err := binding.BindOutputToDevice("logits", memoryInfo); err != nil {
+			t.Fatalf("Failed to bind output: %v", err)
+		}
+		if err := binding.Run(t.Context()); err != nil {
+			t.Fatalf("Failed to run with binding: %v", err)
+		}
+
+		boundOutputs, err := binding.Outputs()
+		if err != nil {
+			t.Fatalf("Failed to get bound outputs: %v", err)
+		}
+		defer func() {
+			for _, v := range boundOutputs {
+				v.Close()
+			}
+		}()
+
+		assertTensorData(t, boundOutputs["logits"], expected, []int64{1, 3})
+
+		memoryInfo.Close()
+		if err := binding.BindOutputToDevice("logits", memoryInfo); err == nil {
+			t.Error("Expected error when binding to closed memory info")
+		}
+	})
+
+	t.Run("ClearBoundInputs", func(t *testing.T) {
+		binding, err := session.NewIoBinding()
+		if err != nil {
+			t.Fatalf("Failed to create io binding: %v", err)
+		}
+		defer binding.Close()
+
+		if err := binding.BindInput("input", inputTensor); err != nil {
+			t.Fatalf("Failed to bind input: %v", err)
+		}
+		if err := binding.BindOutputToCPU("logits"); err != nil {
+			t.Fatalf("Failed to bind output: %v", err)
+		}
+
+		binding.ClearBoundInputs()
+		if err := binding.Run(t.Context()); err == nil {
+			t.Error("Expected error when running without bound inputs")
+		}
+
+		binding.ClearBoundOutputs()
+		boundOutputs, err := binding.Outputs()
+		if err != nil {
+			t.Fatalf("Failed to get bound outputs: %v", err)
+		}
+		if len(boundOutputs) != 0 {
+			t.Errorf("Expected no bound outputs, got %d", len(boundOutputs))
+		}
+	})
+
+	t.Run("InvalidName", func(t *testing.T) {
+		binding, err := session.NewIoBinding()
+		if err != nil {
+			t.Fatalf("Failed to create io binding: %v", err)
+		}
+		defer binding.Close()
+
+		var unknownErr *UnknownInputError
+		if err := binding.BindInput("nonexistent", inputTensor); !errors.As(err, &unknownErr) {
+			t.Errorf("Expected UnknownInputError, got %v", err)
+		}
+	})
+
+	t.Run("InvalidOutputName", func(t *testing.T) {
+		binding, err := session.NewIoBinding()
+		if err != nil {
+			t.Fatalf("Failed to create io binding: %v", err)
+		}
+		defer binding.Close()
+
+		output, err := NewEmptyTensor[float32](runtime, []int64{1, 3})
+		if err != nil {
+			t.Fatalf("Failed to create output tensor: %v", err)
+		}
+		defer output.Close()
+
+		var unknownErr *UnknownOutputError
+		if err := binding.BindOutput("nonexistent", output); !errors.As(err, &unknownErr) {
+			t.Errorf("Expected UnknownOutputError from BindOutput, got %v", err)
+		}
+		if err := binding.BindOutputToCPU("nonexistent"); !errors.As(err, &unknownErr) {
+			t.Errorf("Expected UnknownOutputError from BindOutputToCPU, got %v", err)
+		}
+	})
+
+	t.Run("InputClosedAfterBind", func(t *testing.T) {
+		binding, err := session.NewIoBinding()
+		if err != nil {
+			t.Fatalf("Failed to create io binding: %v", err)
+		}
+		defer binding.Close()
+
+		input, err := NewTensorValue(runtime, []float32{1, 2, 3, 4, 5, 6, 7, 8, 9, 10}, []int64{1, 10})
+		if err != nil {
+			t.Fatalf("Failed to create input tensor: %v", err)
+		}
+		pinned := input.pinned[0]
+		if err := binding.BindInput("input", input); err != nil {
+			t.Fatalf("Failed to bind input: %v", err)
+		}
+		if err := binding.BindOutputToCPU("logits"); err != nil {
+			t.Fatalf("Failed to bind output: %v", err)
+		}
+
+		// The binding keeps the Go memory of the input pinned
+		input.Close()
+		if pinned.data == nil {
+			t.Fatal("Expected bound input data to stay pinned after the value is closed")
+		}
+
+		if err := binding.Run(t.Context()); err != nil {
+			t.Fatalf("Failed to run with binding: %v", err)
+		}
+		boundOutputs, err := binding.Outputs()
+		if err != nil {
+			t.Fatalf("Failed to get bound outputs: %v", err)
+		}
+		assertTensorData(t, boundOutputs["logits"], expected, []int64{1, 3})
+		boundOutputs["logits"].Close()
+
+		binding.ClearBoundInputs()
+		if pinned.data != nil {
+			t.Error("Expected input data to be released after ClearBoundInputs()")
+		}
+	})
+
+	t.Run("InvalidInput", func(t *testing.T) {
+		binding, err := session.NewIoBinding()
+		if err != nil {
+			t.Fatalf("Failed to create io binding: %v", err)
+		}
+		defer binding.Close()
+
+		wrongType, err := NewTensorValue(runtime, make([]int64, 10), []int64{1, 10})
+		if err != nil {
+			t.Fatalf("Failed to create tensor: %v", err)
+		}
+		defer wrongType.Close()
+
+		var typeErr *ElementTypeMismatchError
+		if err := binding.BindInput("input", wrongType); !errors.As(err, &typeErr) {
+			t.Errorf("Expected ElementTypeMismatchError, got %v", err)
+		}
+
+		wrongShape, err := NewTensorValue(runtime, make([]float32, 5), []int64{1, 5})
+		if err != nil {
+			t.Fatalf("Failed to create tensor: %v", err)
+		}
+		defer wrongShape.Close()
+
+		var dimErr *DimensionMismatchError
+		if err := binding.BindInput("input", wrongShape); !errors.As(err, &dimErr) {
+			t.Errorf("Expected DimensionMismatchError, got %v", err)
+		}
+		if len(binding.inputs) != 0 {
+			t.Error("Expected invalid inputs not to be bound")
+		}
+	})
+
+	t.Run("Closed", func(t *testing.T) {
+		binding, err := session.NewIoBinding()
+		if err != nil {
+			t.Fatalf("Failed to create io binding: %v", err)
+		}
+		binding.Close()
+		binding.Close()
+
+		if err := binding.BindInput("input", inputTensor); !errors.Is(err, ErrIoBindingClosed) {
+			t.Errorf("Expected ErrIoBindingClosed, got %v", err)
+		}
+		if err := binding.Run(t.Context()); !errors.Is(err, ErrIoBindingClosed) {
+			t.Errorf("Expected ErrIoBindingClosed, got %v", err)
+		}
+	})
+}
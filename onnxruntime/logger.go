@@ -0,0 +1,112 @@
+package onnxruntime
+
+import (
+	"context"
+	"log/slog"
+	"sync"
+	"sync/atomic"
+
+	"github.com/ebitengine/purego"
+	"github.com/shota3506/onnxruntime-purego/internal/cstrings"
+)
+
+var (
+	// loggingCallback is the native logging function shared by all environments
+	// created with NewEnvWithLogger. purego callbacks cannot be freed, so it is created once.
+	loggingCallback     uintptr
+	loggingCallbackOnce sync.Once
+
+	// envLoggers maps the logger parameter passed to CreateEnvWithCustomLogger
+	// to the logger of the environment. An ID is passed instead of a Go pointer,
+	// which must not be retained by native code.
+	envLoggers      sync.Map // map[uintptr]*slog.Logger
+	nextEnvLoggerID atomic.Uintptr
+
+	// ONNX Runtime shares one native environment between all open Envs, so
+	// the logger of a closed Env keeps receiving messages while any other Env
+	// is open. closedLoggerIDs holds such loggers until the last Env is closed.
+	envMu           sync.Mutex
+	openEnvs        int
+	closedLoggerIDs []uintptr
+)
+
+// getLoggingCallback returns the native logging function for CreateEnvWithCustomLogger.
+func getLoggingCallback() uintptr {
+	loggingCallbackOnce.Do(func() {
+		loggingCallback = purego.NewCallback(onLog)
+	})
+	return loggingCallback
+}
+
+// onLog is invoked by ONNX Runtime for each log message of an environment
+// created with NewEnvWithLogger. The strings are only valid during the call.
+func onLog(param uintptr, severity uintptr, category, logID, codeLocation, message *byte) {
+	v, ok := envLoggers.Load(param)
+	if !ok {
+		return
+	}
+	logger := v.(*slog.Logger)
+
+	logger.LogAttrs(context.Background(), slogLevel(LoggingLevel(int32(severity))), cstrings.CStringToString(message),
+		slog.String("category", cstrings.CStringToString(category)),
+		slog.String("log_id", cstrings.CStringToString(logID)),
+		slog.String("code_location", cstrings.CStringToString(codeLocation)),
+	)
+}
+
+// slogLevel maps an ONNX Runtime logging level to a slog level.
+// Verbose messages are logged below slog.LevelDebug and fatal messages above slog.LevelError.
+func slogLevel(level LoggingLevel) slog.Level {
+	switch level {
+	case LoggingLevelVerbose:
+		return slog.LevelDebug - 4
+	case LoggingLevelInfo:
+		return slog.LevelInfo
+	case LoggingLevelWarning:
+		return slog.LevelWarn
+	case LoggingLevelError:
+		return slog.LevelError
+	case LoggingLevelFatal:
+		return slog.LevelError + 4
+	default:
+		return slog.LevelInfo
+	}
+}
+
+// registerLogger registers logger for the logging callback and returns its ID.
+func registerLogger(logger *slog.Logger) uintptr {
+	id := nextEnvLoggerID.Add(1)
+	envLoggers.Store(id, logger)
+	return id
+}
+
+// unregisterLogger removes a logger registered with registerLogger.
+func unregisterLogger(id uintptr) {
+	envLoggers.Delete(id)
+}
+
+// acquireEnv records that an environment was created.
+func acquireEnv() {
+	envMu.Lock()
+	defer envMu.Unlock()
+	openEnvs++
+}
+
+// releaseEnv records that an environment with the given logger ID, or 0, was
+// released. Loggers are unregistered once no environment is open.
+func releaseEnv(loggerID uintptr) {
+	envMu.Lock()
+	defer envMu.Unlock()
+
+	openEnvs--
+	if loggerID != 0 {
+		closedLoggerIDs = append(closedLoggerIDs, loggerID)
+	}
+	if openEnvs > 0 {
+		return
+	}
+	for _, id := range closedLoggerIDs {
+		unregisterLogger(id)
+	}
+	closedLoggerIDs = nil
+}
@@ -11,6 +11,12 @@ import (
 var libraryPath string
 
 func testModelPath() string {
+	return testDataPath("model.onnx")
+}
+
+// testDataPath returns the path of a file in the shared test data directory.
+// Models other than model.onnx are generated by testdata/gen_models.go.
+func testDataPath(name string) string {
 	_, filename, _, ok := runtime.Caller(0)
 	if !ok {
 		log.Fatal("Failed to get current file path")
@@ -18,9 +24,7 @@ func testModelPath() string {
 
 	// Get the project root directory (parent of onnxruntime package)
 	projectRoot := filepath.Dir(filepath.Dir(filename))
-	modelPath := filepath.Join(projectRoot, "onnxruntime", "internal", "tests", "testdata", "model.onnx")
-
-	return modelPath
+	return filepath.Join(projectRoot, "onnxruntime", "internal", "tests", "testdata", name)
 }
 
 func newTestRuntime(t *testing.T) *Runtime {
@@ -38,6 +42,13 @@ func newTestRuntime(t *testing.T) *Runtime {
 
 func newTestSession(t *testing.T, runtime *Runtime) *Session {
 	t.Helper()
+	return newTestSessionFromFile(t, runtime, testModelPath(), nil)
+}
+
+// newTestSessionFromFile creates a session for the model at modelPath.
+// The session and its environment are closed when the test completes.
+func newTestSessionFromFile(t *testing.T, runtime *Runtime, modelPath string, options *SessionOptions) *Session {
+	t.Helper()
 
 	env, err := runtime.NewEnv("test", LoggingLevelWarning)
 	if err != nil {
@@ -45,13 +56,13 @@ func newTestSession(t *testing.T, runtime *Runtime) *Session {
 	}
 	t.Cleanup(func() { env.Close() })
 
-	modelFile, err := os.Open(testModelPath())
+	modelFile, err := os.Open(modelPath)
 	if err != nil {
 		t.Fatalf("Failed to read model file: %v", err)
 	}
 	defer modelFile.Close()
 
-	session, err := runtime.NewSessionFromReader(env, modelFile, nil)
+	session, err := runtime.NewSessionFromReader(env, modelFile, options)
 	if err != nil {
 		t.Fatalf("Failed to create session: %v", err)
 	}
@@ -0,0 +1,128 @@
+package onnxruntime
+
+import (
+	"fmt"
+	"maps"
+	"slices"
+)
+
+// MapKey is a type constraint for the key types of map values supported by ONNX Runtime.
+type MapKey interface {
+	int64 | string
+}
+
+// MapValue is a type constraint for the value types of map values supported by ONNX Runtime.
+type MapValue interface {
+	int64 | float32 | float64 | string
+}
+
+// NewMapValue creates a new map value from a Go map.
+// The data must not be empty. The contents are copied into the map value.
+func NewMapValue[K MapKey, V MapValue](r *Runtime, data map[K]V) (*Value, error) {
+	if len(data) == 0 {
+		return nil, fmt.Errorf("data cannot be empty")
+	}
+
+	keys := slices.Sorted(maps.Keys(data))
+	values := make([]V, len(keys))
+	for i, k := range keys {
+		values[i] = data[k]
+	}
+
+	keysValue, err := newMapTensorValue(r, keys)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create keys tensor: %w", err)
+	}
+	defer keysValue.Close()
+
+	valuesValue, err := newMapTensorValue(r, values)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create values tensor: %w", err)
+	}
+	defer valuesValue.Close()
+
+	value, err := r.newValueFromValues([]*Value{keysValue, valuesValue}, ONNXTypeMap)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create map: %w", err)
+	}
+	return value, nil
+}
+
+// GetMapData extracts the contents of a map value as a Go map.
+// K and V must match the key and value types of the map, e.g.
+// GetMapData[int64, float32] for the map(int64, float) outputs of ZipMap.
+func GetMapData[K MapKey, V MapValue](v *Value) (map[K]V, error) {
+	if err := v.checkValueType(ONNXTypeMap); err != nil {
+		return nil, err
+	}
+
+	keysValue, err := v.getValue(0)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get map keys: %w", err)
+	}
+	defer keysValue.Close()
+
+	valuesValue, err := v.getValue(1)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get map values: %w", err)
+	}
+	defer valuesValue.Close()
+
+	keys, err := getMapTensorData[K](keysValue)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get map keys: %w", err)
+	}
+	values, err := getMapTensorData[V](valuesValue)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get map values: %w", err)
+	}
+	if len(keys) != len(values) {
+		return nil, fmt.Errorf("map keys and values length mismatch: %d != %d", len(keys), len(values))
+	}
+
+	result := make(map[K]V, len(keys))
+	for i, k := range keys {
+		result[k] = values[i]
+	}
+	return result, nil
+}
+
+// newMapTensorValue creates a 1-D tensor holding map keys or values.
+func newMapTensorValue[T MapValue](r *Runtime, data []T) (*Value, error) {
+	shape := []int64{int64(len(data))}
+	switch d := any(data).(type) {
+	case []int64:
+		return NewTensorValue(r, d, shape)
+	case []float32:
+		return NewTensorValue(r, d, shape)
+	case []float64:
+		return NewTensorValue(r, d, shape)
+	case []string:
+		return NewStringTensorValue(r, d, shape)
+	default:
+		return nil, fmt.Errorf("unsupported data type")
+	}
+}
+
+// getMapTensorData extracts the keys or values tensor of a map.
+func getMapTensorData[T MapValue](v *Value) ([]T, error) {
+	var data any
+	var err error
+	var zero T
+	switch any(zero).(type) {
+	case int64:
+		data, _, err = GetTensorData[int64](v)
+	case float32:
+		data, _, err = GetTensorData[float32](v)
+	case float64:
+		data, _, err = GetTensorData[float64](v)
+	case string:
+		data, _, err = GetStringTensorData(v)
+	default:
+		return nil, fmt.Errorf("unsupported data type")
+	}
+	if err != nil {
+		return nil, err
+	}
+	return data.([]T), nil
+}
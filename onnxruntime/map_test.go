@@ -0,0 +1,92 @@
+package onnxruntime
+
+import (
+	"maps"
+	"testing"
+)
+
+func TestNewMapValue(t *testing.T) {
+	runtime := newTestRuntime(t)
+
+	t.Run("Int64ToFloat32", func(t *testing.T) {
+		expected := map[int64]float32{0: 0.1, 1: 0.2, 2: 0.7}
+
+		m, err := NewMapValue(runtime, expected)
+		if err != nil {
+			t.Fatalf("Failed to create map: %v", err)
+		}
+		defer m.Close()
+
+		valueType, err := m.GetValueType()
+		if err != nil {
+			t.Fatalf("Failed to get value type: %v", err)
+		}
+		if valueType != ONNXTypeMap {
+			t.Errorf("Expected value type to be ONNXTypeMap, got %d", valueType)
+		}
+
+		data, err := GetMapData[int64, float32](m)
+		if err != nil {
+			t.Fatalf("Failed to get map data: %v", err)
+		}
+		if !maps.Equal(data, expected) {
+			t.Errorf("Map mismatch: expected %v, got %v", expected, data)
+		}
+	})
+
+	t.Run("StringToInt64", func(t *testing.T) {
+		expected := map[string]int64{"cat": 1, "dog": 2}
+
+		m, err := NewMapValue(runtime, expected)
+		if err != nil {
+			t.Fatalf("Failed to create map: %v", err)
+		}
+		defer m.Close()
+
+		data, err := GetMapData[string, int64](m)
+		if err != nil {
+			t.Fatalf("Failed to get map data: %v", err)
+		}
+		if !maps.Equal(data, expected) {
+			t.Errorf("Map mismatch: expected %v, got %v", expected, data)
+		}
+	})
+
+	t.Run("EmptyData", func(t *testing.T) {
+		_, err := NewMapValue(runtime, map[int64]float32{})
+		if err == nil {
+			t.Error("Expected error when creating map with empty data")
+		}
+	})
+}
+
+func TestGetMapData(t *testing.T) {
+	runtime := newTestRuntime(t)
+
+	t.Run("TypeMismatch", func(t *testing.T) {
+		m, err := NewMapValue(runtime, map[int64]float32{0: 1.0})
+		if err != nil {
+			t.Fatalf("Failed to create map: %v", err)
+		}
+		defer m.Close()
+
+		if _, err := GetMapData[string, float32](m); err == nil {
+			t.Error("Expected error when key type does not match")
+		}
+		if _, err := GetMapData[int64, int64](m); err == nil {
+			t.Error("Expected error when value type does not match")
+		}
+	})
+
+	t.Run("NotMap", func(t *testing.T) {
+		tensor, err := NewTensorValue(runtime, []float32{1.0}, []int64{1})
+		if err != nil {
+			t.Fatalf("Failed to create tensor: %v", err)
+		}
+		defer tensor.Close()
+
+		if _, err := GetMapData[int64, float32](tensor); err == nil {
+			t.Error("Expected error when getting map data of a tensor")
+		}
+	})
+}
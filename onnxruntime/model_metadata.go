@@ -0,0 +1,122 @@
+package onnxruntime
+
+import (
+	"errors"
+	"fmt"
+	"unsafe"
+
+	"github.com/shota3506/onnxruntime-purego/onnxruntime/internal/api"
+)
+
+// ModelMetadata holds the metadata embedded in an ONNX model.
+type ModelMetadata struct {
+	// ProducerName is the name of the tool that produced the model (e.g. "pytorch").
+	ProducerName string
+
+	// GraphName is the name of the model graph.
+	GraphName string
+
+	// GraphDescription is the documentation string of the model graph.
+	GraphDescription string
+
+	// Domain is the model domain (e.g. "ai.onnx").
+	Domain string
+
+	// Description is the documentation string of the model.
+	Description string
+
+	// Version is the model version.
+	Version int64
+
+	// CustomMetadata holds the custom key/value metadata (metadata_props) of the model.
+	CustomMetadata map[string]string
+}
+
+// Metadata returns the metadata embedded in the model loaded by the session.
+func (s *Session) Metadata() (*ModelMetadata, error) {
+	if s.ptr == 0 {
+		return nil, ErrSessionClosed
+	}
+
+	if s.runtime.allocator == nil {
+		return nil, errors.New("allocator not initialized")
+	}
+
+	var metadataPtr api.OrtModelMetadata
+	status := s.runtime.apiFuncs.SessionGetModelMetadata(s.ptr, &metadataPtr)
+	if err := s.runtime.statusError(status, "SessionGetModelMetadata"); err != nil {
+		return nil, fmt.Errorf("failed to get model metadata: %w", err)
+	}
+	defer s.runtime.apiFuncs.ReleaseModelMetadata(metadataPtr)
+
+	metadata := &ModelMetadata{}
+
+	fields := []struct {
+		name   string
+		op     string
+		getter func(api.OrtModelMetadata, api.OrtAllocator, **byte) api.OrtStatus
+		dst    *string
+	}{
+		{"producer name", "ModelMetadataGetProducerName", s.runtime.apiFuncs.ModelMetadataGetProducerName, &metadata.ProducerName},
+		{"graph name", "ModelMetadataGetGraphName", s.runtime.apiFuncs.ModelMetadataGetGraphName, &metadata.GraphName},
+		{"graph description", "ModelMetadataGetGraphDescription", s.runtime.apiFuncs.ModelMetadataGetGraphDescription, &metadata.GraphDescription},
+		{"domain", "ModelMetadataGetDomain", s.runtime.apiFuncs.ModelMetadataGetDomain, &metadata.Domain},
+		{"description", "ModelMetadataGetDescription", s.runtime.apiFuncs.ModelMetadataGetDescription, &metadata.Description},
+	}
+	for _, field := range fields {
+		var valuePtr *byte
+		status := field.getter(metadataPtr, s.runtime.allocator.ptr, &valuePtr)
+		if err := s.runtime.statusError(status, field.op); err != nil {
+			return nil, fmt.Errorf("failed to get model %s: %w", field.name, err)
+		}
+		*field.dst = s.runtime.allocator.takeString(valuePtr)
+	}
+
+	status = s.runtime.apiFuncs.ModelMetadataGetVersion(metadataPtr, &metadata.Version)
+	if err := s.runtime.statusError(status, "ModelMetadataGetVersion"); err != nil {
+		return nil, fmt.Errorf("failed to get model version: %w", err)
+	}
+
+	customMetadata, err := s.runtime.getCustomMetadataMap(metadataPtr)
+	if err != nil {
+		return nil, err
+	}
+	metadata.CustomMetadata = customMetadata
+
+	return metadata, nil
+}
+
+// getCustomMetadataMap reads all custom metadata key/value pairs from the model metadata.
+func (r *Runtime) getCustomMetadataMap(metadataPtr api.OrtModelMetadata) (map[string]string, error) {
+	var keysPtr **byte
+	var numKeys int64
+	status := r.apiFuncs.ModelMetadataGetCustomMetadataMapKeys(metadataPtr, r.allocator.ptr, &keysPtr, &numKeys)
+	if err := r.statusError(status, "ModelMetadataGetCustomMetadataMapKeys"); err != nil {
+		return nil, fmt.Errorf("failed to get custom metadata keys: %w", err)
+	}
+
+	customMetadata := make(map[string]string, numKeys)
+	if numKeys == 0 || keysPtr == nil {
+		return customMetadata, nil
+	}
+
+	// Both the key array and each key are allocated by the allocator.
+	keyPtrs := unsafe.Slice(keysPtr, numKeys)
+	keys := make([]string, numKeys)
+	for i, keyPtr := range keyPtrs {
+		keys[i] = r.allocator.takeString(keyPtr)
+	}
+	r.allocator.free(unsafe.Pointer(keysPtr))
+
+	for _, key := range keys {
+		keyBytes := append([]byte(key), 0)
+		var valuePtr *byte
+		status := r.apiFuncs.ModelMetadataLookupCustomMetadataMap(metadataPtr, r.allocator.ptr, &keyBytes[0], &valuePtr)
+		if err := r.statusError(status, "ModelMetadataLookupCustomMetadataMap"); err != nil {
+			return nil, fmt.Errorf("failed to look up custom metadata %q: %w", key, err)
+		}
+		customMetadata[key] = r.allocator.takeString(valuePtr)
+	}
+
+	return customMetadata, nil
+}
@@ -0,0 +1,72 @@
+package onnxruntime
+
+import (
+	"errors"
+	"maps"
+	"testing"
+)
+
+func TestSessionMetadata(t *testing.T) {
+	session := newTestSession(t, newTestRuntime(t))
+
+	metadata, err := session.Metadata()
+	if err != nil {
+		t.Fatalf("Failed to get model metadata: %v", err)
+	}
+
+	if metadata.ProducerName != "pytorch" {
+		t.Errorf("Expected producer name 'pytorch', got %q", metadata.ProducerName)
+	}
+	if metadata.CustomMetadata == nil {
+		t.Error("Custom metadata map should not be nil")
+	}
+}
+
+func TestSessionMetadataFields(t *testing.T) {
+	runtime := newTestRuntime(t)
+	session := newTestSessionFromFile(t, runtime, testDataPath("metadata.onnx"), nil)
+
+	metadata, err := session.Metadata()
+	if err != nil {
+		t.Fatalf("Failed to get model metadata: %v", err)
+	}
+
+	stringFields := []struct {
+		name     string
+		actual   string
+		expected string
+	}{
+		{"ProducerName", metadata.ProducerName, "onnxruntime-purego"},
+		{"GraphName", metadata.GraphName, "metadata"},
+		{"GraphDescription", metadata.GraphDescription, "Identity graph"},
+		{"Domain", metadata.Domain, "ai.onnxruntime-purego.test"},
+		{"Description", metadata.Description, "Model with metadata"},
+	}
+	for _, f := range stringFields {
+		if f.actual != f.expected {
+			t.Errorf("Expected %s %q, got %q", f.name, f.expected, f.actual)
+		}
+	}
+	if metadata.Version != 7 {
+		t.Errorf("Expected Version 7, got %d", metadata.Version)
+	}
+
+	expectedCustom := map[string]string{
+		"labels": "cat,dog,bird",
+		"author": "onnxruntime-purego",
+		"empty":  "",
+	}
+	if !maps.Equal(metadata.CustomMetadata, expectedCustom) {
+		t.Errorf("Expected custom metadata %v, got %v", expectedCustom, metadata.CustomMetadata)
+	}
+}
+
+func TestSessionMetadataWithClosedSession(t *testing.T) {
+	session := newTestSession(t, newTestRuntime(t))
+	session.Close()
+
+	_, err := session.Metadata()
+	if !errors.Is(err, ErrSessionClosed) {
+		t.Errorf("Expected ErrSessionClosed, got: %v", err)
+	}
+}
@@ -2,6 +2,7 @@ package onnxruntime
 
 import (
 	"errors"
+	"fmt"
 
 	"github.com/shota3506/onnxruntime-purego/onnxruntime/internal/api"
 )
@@ -9,6 +10,18 @@ import (
 var (
 	// ErrSessionClosed is returned when an operation is attempted on a closed session.
 	ErrSessionClosed = errors.New("session is closed")
+
+	// ErrIoBindingClosed is returned when an operation is attempted on a closed I/O binding.
+	ErrIoBindingClosed = errors.New("io binding is closed")
+
+	// ErrPreparedRunClosed is returned when an operation is attempted on a closed prepared run.
+	ErrPreparedRunClosed = errors.New("prepared run is closed")
+
+	// ErrValueClosed is returned when an operation is attempted on a closed value.
+	ErrValueClosed = errors.New("value is closed")
+
+	// ErrEmptyOptional is returned when the element of an empty optional value is accessed.
+	ErrEmptyOptional = errors.New("optional value is empty")
 )
 
 // ErrorCode represents error codes returned by the ONNX Runtime C API.
@@ -80,6 +93,21 @@ const (
 	ONNXTypeOptional ONNXType = 6
 )
 
+// SparseFormat represents the storage format of a sparse tensor.
+type SparseFormat = api.OrtSparseFormat
+
+// Sparse tensor formats.
+const (
+	// SparseFormatUndefined indicates an undefined sparse format.
+	SparseFormatUndefined SparseFormat = 0
+	// SparseFormatCOO indicates the coordinate (COO) format.
+	SparseFormatCOO SparseFormat = 1
+	// SparseFormatCSR indicates the compressed sparse row (CSR) format.
+	SparseFormatCSR SparseFormat = 2
+	// SparseFormatBlockSparse indicates the block sparse format.
+	SparseFormatBlockSparse SparseFormat = 4
+)
+
 // ONNXTensorElementDataType represents the data type of tensor elements.
 type ONNXTensorElementDataType = api.ONNXTensorElementDataType
 
@@ -117,6 +145,8 @@ const (
 	ONNXTensorElementDataTypeComplex64 ONNXTensorElementDataType = 14
 	// ONNXTensorElementDataTypeComplex128 indicates complex128 data type.
 	ONNXTensorElementDataTypeComplex128 ONNXTensorElementDataType = 15
+	// ONNXTensorElementDataTypeBFloat16 indicates bfloat16 data type.
+	ONNXTensorElementDataTypeBFloat16 ONNXTensorElementDataType = 16
 )
 
 // allocatorType represents memory allocator types.
@@ -133,6 +163,56 @@ type memType = api.OrtMemType
 
 // Memory types for allocations.
 const (
-	// memTypeCPU indicates general CPU memory.
-	memTypeCPU memType = 0
+	// memTypeDefault indicates the default memory of the device,
+	// which is general CPU memory for the CPU.
+	memTypeDefault memType = 0
+)
+
+// GraphOptimizationLevel represents the level of graph optimizations applied to a model.
+// Unlike in the C API, the zero value selects the ONNX Runtime default, so levels
+// are converted to their C API values when applied.
+type GraphOptimizationLevel int
+
+// Graph optimization levels.
+const (
+	// GraphOptimizationLevelDefault uses the ONNX Runtime default (all optimizations enabled).
+	GraphOptimizationLevelDefault GraphOptimizationLevel = iota
+	// GraphOptimizationLevelDisabled disables all graph optimizations.
+	GraphOptimizationLevelDisabled
+	// GraphOptimizationLevelBasic enables basic optimizations such as constant folding
+	// and redundant node elimination.
+	GraphOptimizationLevelBasic
+	// GraphOptimizationLevelExtended enables basic and extended optimizations such as
+	// complex node fusions.
+	GraphOptimizationLevelExtended
+	// GraphOptimizationLevelAll enables all optimizations, including layout optimizations.
+	GraphOptimizationLevelAll
+)
+
+// toAPI converts the level to the value expected by the ONNX Runtime C API.
+func (l GraphOptimizationLevel) toAPI() (api.GraphOptimizationLevel, error) {
+	switch l {
+	case GraphOptimizationLevelDisabled:
+		return 0, nil
+	case GraphOptimizationLevelBasic:
+		return 1, nil
+	case GraphOptimizationLevelExtended:
+		return 2, nil
+	case GraphOptimizationLevelDefault, GraphOptimizationLevelAll:
+		return 99, nil
+	default:
+		return 0, fmt.Errorf("unknown graph optimization level: %d", l)
+	}
+}
+
+// ExecutionMode represents how the operators of a graph are executed.
+type ExecutionMode = api.ExecutionMode
+
+// Execution modes.
+const (
+	// ExecutionModeSequential executes operators one after another.
+	ExecutionModeSequential ExecutionMode = 0
+	// ExecutionModeParallel executes independent operators in parallel
+	// using the inter-op thread pool.
+	ExecutionModeParallel ExecutionMode = 1
 )
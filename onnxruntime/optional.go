@@ -0,0 +1,50 @@
+package onnxruntime
+
+import (
+	"fmt"
+)
+
+// NewEmptyOptionalValue creates a value representing an optional without an element.
+// Passing it to Session.Run explicitly provides no value for an optional input;
+// such inputs are omitted from the inputs fed to ONNX Runtime.
+func NewEmptyOptionalValue(r *Runtime) *Value {
+	return &Value{
+		runtime:       r,
+		emptyOptional: true,
+	}
+}
+
+// HasValue reports whether the value holds an element.
+// It returns false for an empty optional value, such as an optional output
+// that was not produced by the model, and true for all other values.
+func (v *Value) HasValue() (bool, error) {
+	if v.emptyOptional {
+		return false, nil
+	}
+	if err := v.checkOpen(); err != nil {
+		return false, err
+	}
+
+	var out int32
+	status := v.runtime.apiFuncs.HasValue(v.ptr, &out)
+	if err := v.runtime.statusError(status, "HasValue"); err != nil {
+		return false, fmt.Errorf("failed to check value: %w", err)
+	}
+	return out != 0, nil
+}
+
+// Unwrap returns the element of an optional value.
+// It returns ErrEmptyOptional if the optional value is empty.
+//
+// ONNX Runtime stores the element of a non-empty optional directly, so the
+// returned value is v itself and must not be closed separately.
+func (v *Value) Unwrap() (*Value, error) {
+	ok, err := v.HasValue()
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, ErrEmptyOptional
+	}
+	return v, nil
+}
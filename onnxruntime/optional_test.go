@@ -0,0 +1,196 @@
+package onnxruntime
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestValueHasValue(t *testing.T) {
+	runtime := newTestRuntime(t)
+
+	t.Run("Tensor", func(t *testing.T) {
+		tensor, err := NewTensorValue(runtime, []float32{1.0, 2.0}, []int64{2})
+		if err != nil {
+			t.Fatalf("Failed to create tensor: %v", err)
+		}
+		defer tensor.Close()
+
+		ok, err := tensor.HasValue()
+		if err != nil {
+			t.Fatalf("Failed to check value: %v", err)
+		}
+		if !ok {
+			t.Error("Expected tensor to have a value")
+		}
+
+		element, err := tensor.Unwrap()
+		if err != nil {
+			t.Fatalf("Failed to unwrap value: %v", err)
+		}
+		if element != tensor {
+			t.Error("Expected unwrapped value to be the tensor itself")
+		}
+	})
+
+	t.Run("EmptyOptional", func(t *testing.T) {
+		value := NewEmptyOptionalValue(runtime)
+		defer value.Close()
+
+		ok, err := value.HasValue()
+		if err != nil {
+			t.Fatalf("Failed to check value: %v", err)
+		}
+		if ok {
+			t.Error("Expected empty optional to have no value")
+		}
+
+		valueType, err := value.GetValueType()
+		if err != nil {
+			t.Fatalf("Failed to get value type: %v", err)
+		}
+		if valueType != ONNXTypeOptional {
+			t.Errorf("Expected value type to be ONNXTypeOptional, got %d", valueType)
+		}
+
+		if _, err := value.Unwrap(); !errors.Is(err, ErrEmptyOptional) {
+			t.Errorf("Expected ErrEmptyOptional, got %v", err)
+		}
+	})
+}
+
+func TestSessionRunWithEmptyOptionalInput(t *testing.T) {
+	runtime := newTestRuntime(t)
+
+	env, err := runtime.NewEnv("test", LoggingLevelWarning)
+	if err != nil {
+		t.Fatalf("Failed to create environment: %v", err)
+	}
+	defer env.Close()
+
+	session, err := runtime.NewSession(env, testModelPath(), nil)
+	if err != nil {
+		t.Fatalf("Failed to create session: %v", err)
+	}
+	defer session.Close()
+
+	// The test model input is not optional, so leaving it empty must be
+	// reported as a missing input rather than being passed as a null value.
+	_, err = session.Run(t.Context(), map[string]*Value{
+		"input": NewEmptyOptionalValue(runtime),
+	})
+	var missingErr *MissingInputError
+	if !errors.As(err, &missingErr) {
+		t.Errorf("Expected MissingInputError for missing required input, got %v", err)
+	}
+}
+
+func TestSessionRunWithOptionalInput(t *testing.T) {
+	runtime := newTestRuntime(t)
+	session := newTestSessionFromFile(t, runtime, testDataPath("optional.onnx"), nil)
+
+	info := session.InputInfo()[0]
+	if info.Type != ONNXTypeOptional {
+		t.Fatalf("Expected optional input, got type %d", info.Type)
+	}
+
+	tensor, err := NewTensorValue(runtime, []float32{1.0, 2.0, 3.0}, []int64{3})
+	if err != nil {
+		t.Fatalf("Failed to create tensor: %v", err)
+	}
+	defer tensor.Close()
+
+	testCases := []struct {
+		name     string
+		inputs   map[string]*Value
+		expected bool
+	}{
+		{"Fed", map[string]*Value{"x": tensor}, true},
+		{"Empty", map[string]*Value{"x": NewEmptyOptionalValue(runtime)}, false},
+		{"Omitted", map[string]*Value{}, false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			outputs, err := session.Run(t.Context(), tc.inputs)
+			if err != nil {
+				t.Fatalf("Failed to run inference: %v", err)
+			}
+			defer outputs["has_element"].Close()
+
+			assertTensorData(t, outputs["has_element"], []bool{tc.expected}, []int64{})
+		})
+	}
+}
+
+func TestSessionRunWithOptionalOutputs(t *testing.T) {
+	runtime := newTestRuntime(t)
+	session := newTestSessionFromFile(t, runtime, testDataPath("optional_output.onnx"), nil)
+
+	for _, info := range session.OutputInfo() {
+		if info.Type != ONNXTypeOptional {
+			t.Errorf("Expected output %q to be optional, got type %d", info.Name, info.Type)
+		}
+	}
+
+	input, err := NewTensorValue(runtime, []float32{1.0, 2.0, 3.0}, []int64{3})
+	if err != nil {
+		t.Fatalf("Failed to create tensor: %v", err)
+	}
+	defer input.Close()
+
+	outputs, err := session.Run(t.Context(), map[string]*Value{"x": input})
+	if err != nil {
+		t.Fatalf("Failed to run inference: %v", err)
+	}
+	defer outputs["some"].Close()
+	defer outputs["none"].Close()
+
+	t.Run("Some", func(t *testing.T) {
+		some := outputs["some"]
+
+		ok, err := some.HasValue()
+		if err != nil {
+			t.Fatalf("Failed to check value: %v", err)
+		}
+		if !ok {
+			t.Fatal("Expected optional output to have a value")
+		}
+
+		element, err := some.Unwrap()
+		if err != nil {
+			t.Fatalf("Failed to unwrap value: %v", err)
+		}
+		valueType, err := element.GetValueType()
+		if err != nil {
+			t.Fatalf("Failed to get value type: %v", err)
+		}
+		if valueType != ONNXTypeTensor {
+			t.Errorf("Expected element type to be ONNXTypeTensor, got %d", valueType)
+		}
+		assertTensorData(t, element, []float32{1.0, 2.0, 3.0}, []int64{3})
+	})
+
+	t.Run("None", func(t *testing.T) {
+		none := outputs["none"]
+
+		ok, err := none.HasValue()
+		if err != nil {
+			t.Fatalf("Failed to check value: %v", err)
+		}
+		if ok {
+			t.Error("Expected optional output to have no value")
+		}
+
+		valueType, err := none.GetValueType()
+		if err != nil {
+			t.Fatalf("Failed to get value type: %v", err)
+		}
+		if valueType != ONNXTypeOptional {
+			t.Errorf("Expected value type to be ONNXTypeOptional, got %d", valueType)
+		}
+
+		if _, err := none.Unwrap(); !errors.Is(err, ErrEmptyOptional) {
+			t.Errorf("Expected ErrEmptyOptional, got %v", err)
+		}
+	})
+}
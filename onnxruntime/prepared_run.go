@@ -0,0 +1,190 @@
+package onnxruntime
+
+import (
+	"context"
+	"fmt"
+	"runtime"
+	"slices"
+
+	"github.com/shota3506/onnxruntime-purego/onnxruntime/internal/api"
+)
+
+// PreparedRun is a run of a session with a fixed set of inputs and outputs.
+// The native name arrays and run options are built once by Session.Prepare
+// and reused by every call to Run, which takes and returns values positionally
+// instead of by name.
+//
+// A PreparedRun is not safe for concurrent use; prepare one per goroutine.
+type PreparedRun struct {
+	session     *Session
+	inputNames  []string
+	outputNames []string
+	inputInfo   []TensorInfo
+
+	// Native arguments reused across runs
+	inputNamePtrs   []*byte
+	outputNamePtrs  []*byte
+	inputValuePtrs  []api.OrtValue
+	outputValuePtrs []api.OrtValue
+	pinner          runtime.Pinner
+	runOptions      *runOptions
+}
+
+// Prepare creates a PreparedRun that feeds the named inputs and computes the
+// named outputs, in the given order. If outputNames is empty, all model outputs
+// are computed. All required inputs of the model must be named; optional inputs
+// may be left out. The PreparedRun must be closed when no longer needed.
+func (s *Session) Prepare(inputNames, outputNames []string) (*PreparedRun, error) {
+	if s.ptr == 0 {
+		return nil, ErrSessionClosed
+	}
+	if len(outputNames) == 0 {
+		outputNames = s.outputNames
+	}
+
+	inputInfo := make([]TensorInfo, len(inputNames))
+	for i, name := range inputNames {
+		info, ok := s.inputInfoByName(name)
+		if !ok {
+			return nil, &UnknownInputError{Name: name}
+		}
+		if slices.Contains(inputNames[:i], name) {
+			return nil, fmt.Errorf("duplicate input name %q", name)
+		}
+		inputInfo[i] = info
+	}
+	for i, info := range s.inputInfo {
+		if info.Type != ONNXTypeOptional && !slices.Contains(inputNames, s.inputNames[i]) {
+			return nil, &MissingInputError{Name: s.inputNames[i]}
+		}
+	}
+	for i, name := range outputNames {
+		if !slices.Contains(s.outputNames, name) {
+			return nil, &UnknownOutputError{Name: name}
+		}
+		if slices.Contains(outputNames[:i], name) {
+			return nil, fmt.Errorf("duplicate output name %q", name)
+		}
+	}
+
+	ro, err := s.runtime.newRunOptions(&runConfig{})
+	if err != nil {
+		return nil, err
+	}
+
+	p := &PreparedRun{
+		session:         s,
+		inputNames:      slices.Clone(inputNames),
+		outputNames:     slices.Clone(outputNames),
+		inputInfo:       inputInfo,
+		inputNamePtrs:   make([]*byte, len(inputNames)),
+		outputNamePtrs:  make([]*byte, len(outputNames)),
+		inputValuePtrs:  make([]api.OrtValue, len(inputNames)),
+		outputValuePtrs: make([]api.OrtValue, len(outputNames)),
+		runOptions:      ro,
+	}
+
+	for i, name := range inputNames {
+		nameBytes := append([]byte(name), 0)
+		p.inputNamePtrs[i] = &nameBytes[0]
+		p.pinner.Pin(p.inputNamePtrs[i])
+	}
+	for i, name := range outputNames {
+		nameBytes := append([]byte(name), 0)
+		p.outputNamePtrs[i] = &nameBytes[0]
+		p.pinner.Pin(p.outputNamePtrs[i])
+	}
+	pinSlice(&p.pinner, p.inputNamePtrs)
+	pinSlice(&p.pinner, p.outputNamePtrs)
+
+	return p, nil
+}
+
+// InputNames returns the names of the inputs, in the order expected by Run.
+func (p *PreparedRun) InputNames() []string {
+	return p.inputNames
+}
+
+// OutputNames returns the names of the outputs, in the order returned by Run.
+func (p *PreparedRun) OutputNames() []string {
+	return p.outputNames
+}
+
+// Run executes the model with inputs given in the order of InputNames and
+// returns the computed outputs in the order of OutputNames.
+//
+// If ctx is cancelled or its deadline expires while the model is running, the
+// inference is terminated and the returned error wraps both ctx.Err() and the
+// RuntimeError reported by ONNX Runtime.
+func (p *PreparedRun) Run(ctx context.Context, inputs []*Value) ([]*Value, error) {
+	if p.session == nil {
+		return nil, ErrPreparedRunClosed
+	}
+	s := p.session
+	if s.ptr == 0 {
+		return nil, ErrSessionClosed
+	}
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	if len(inputs) != len(p.inputNames) {
+		return nil, fmt.Errorf("number of inputs (%d) must match number of prepared input names (%d)", len(inputs), len(p.inputNames))
+	}
+
+	for i, input := range inputs {
+		if input == nil {
+			return nil, fmt.Errorf("input %q is nil", p.inputNames[i])
+		}
+		if err := validateInput(p.inputInfo[i], input); err != nil {
+			return nil, err
+		}
+		p.inputValuePtrs[i] = input.ptr
+	}
+	clear(p.outputValuePtrs)
+
+	// The run options are shared by every run, so the terminate flag set by
+	// a cancelled ctx is cleared before the next run.
+	if ctx.Done() != nil {
+		stop := p.runOptions.watchContext(ctx)
+		defer func() {
+			stop()
+			if ctx.Err() != nil {
+				_ = p.runOptions.unsetTerminate()
+			}
+		}()
+	}
+
+	status := s.runtime.apiFuncs.Run(
+		s.ptr,
+		p.runOptions.ptr,
+		firstElement(p.inputNamePtrs),
+		firstElement(p.inputValuePtrs),
+		uintptr(len(p.inputValuePtrs)),
+		firstElement(p.outputNamePtrs),
+		uintptr(len(p.outputNamePtrs)),
+		firstElement(p.outputValuePtrs),
+	)
+	if err := s.runtime.statusError(status, "Run"); err != nil {
+		err = fmt.Errorf("failed to run inference: %w", err)
+		if ctxErr := ctx.Err(); ctxErr != nil {
+			return nil, fmt.Errorf("%w: %w", ctxErr, err)
+		}
+		return nil, err
+	}
+
+	outputs := make([]*Value, len(p.outputValuePtrs))
+	for i, ptr := range p.outputValuePtrs {
+		outputs[i] = s.runtime.newValueFromPtr(ptr)
+	}
+	return outputs, nil
+}
+
+// Close releases the resources held by the prepared run.
+// It does not close the session.
+func (p *PreparedRun) Close() {
+	if p.session != nil {
+		p.pinner.Unpin()
+		p.runOptions.release()
+		p.session = nil
+	}
+}
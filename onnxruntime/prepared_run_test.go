@@ -0,0 +1,157 @@
+package onnxruntime
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestPreparedRun(t *testing.T) {
+	runtime := newTestRuntime(t)
+	session := newTestSession(t, runtime)
+
+	inputTensor, err := NewTensorValue(runtime, []float32{1, 2, 3, 4, 5, 6, 7, 8, 9, 10}, []int64{1, 10})
+	if err != nil {
+		t.Fatalf("Failed to create input tensor: %v", err)
+	}
+	defer inputTensor.Close()
+
+	// Reference output from a regular run
+	outputs, err := session.Run(t.Context(), map[string]*Value{"input": inputTensor})
+	if err != nil {
+		t.Fatalf("Failed to run inference: %v", err)
+	}
+	expected, _, err := GetTensorData[float32](outputs["logits"])
+	if err != nil {
+		t.Fatalf("Failed to get output data: %v", err)
+	}
+	outputs["logits"].Close()
+
+	t.Run("Run", func(t *testing.T) {
+		prepared, err := session.Prepare([]string{"input"}, nil)
+		if err != nil {
+			t.Fatalf("Failed to prepare run: %v", err)
+		}
+		defer prepared.Close()
+
+		if got := prepared.OutputNames(); len(got) != 1 || got[0] != "logits" {
+			t.Fatalf("Expected output names [logits], got %v", got)
+		}
+
+		for range 3 {
+			outputs, err := prepared.Run(t.Context(), []*Value{inputTensor})
+			if err != nil {
+				t.Fatalf("Failed to run inference: %v", err)
+			}
+			if len(outputs) != 1 {
+				t.Fatalf("Expected 1 output, got %d", len(outputs))
+			}
+			assertTensorData(t, outputs[0], expected, []int64{1, 3})
+			outputs[0].Close()
+		}
+	})
+
+	t.Run("UnknownName", func(t *testing.T) {
+		var unknownInputErr *UnknownInputError
+		if _, err := session.Prepare([]string{"unknown"}, nil); !errors.As(err, &unknownInputErr) {
+			t.Errorf("Expected UnknownInputError, got: %v", err)
+		}
+		var unknownOutputErr *UnknownOutputError
+		if _, err := session.Prepare([]string{"input"}, []string{"unknown"}); !errors.As(err, &unknownOutputErr) {
+			t.Errorf("Expected UnknownOutputError, got: %v", err)
+		}
+	})
+
+	t.Run("MissingInput", func(t *testing.T) {
+		var missingErr *MissingInputError
+		if _, err := session.Prepare(nil, nil); !errors.As(err, &missingErr) {
+			t.Fatalf("Expected MissingInputError, got: %v", err)
+		}
+		if missingErr.Name != "input" {
+			t.Errorf("Expected missing input %q, got %q", "input", missingErr.Name)
+		}
+	})
+
+	t.Run("DuplicateName", func(t *testing.T) {
+		if _, err := session.Prepare([]string{"input", "input"}, nil); err == nil {
+			t.Error("Expected error for duplicate input name")
+		}
+		if _, err := session.Prepare([]string{"input"}, []string{"logits", "logits"}); err == nil {
+			t.Error("Expected error for duplicate output name")
+		}
+	})
+
+	t.Run("WrongInputCount", func(t *testing.T) {
+		prepared, err := session.Prepare([]string{"input"}, nil)
+		if err != nil {
+			t.Fatalf("Failed to prepare run: %v", err)
+		}
+		defer prepared.Close()
+
+		if _, err := prepared.Run(t.Context(), nil); err == nil {
+			t.Error("Expected error for wrong input count")
+		}
+	})
+
+	t.Run("InvalidInput", func(t *testing.T) {
+		prepared, err := session.Prepare([]string{"input"}, nil)
+		if err != nil {
+			t.Fatalf("Failed to prepare run: %v", err)
+		}
+		defer prepared.Close()
+
+		wrongType, err := NewTensorValue(runtime, make([]int64, 10), []int64{1, 10})
+		if err != nil {
+			t.Fatalf("Failed to create tensor: %v", err)
+		}
+		defer wrongType.Close()
+
+		var typeErr *ElementTypeMismatchError
+		if _, err := prepared.Run(t.Context(), []*Value{wrongType}); !errors.As(err, &typeErr) {
+			t.Errorf("Expected ElementTypeMismatchError, got: %v", err)
+		}
+
+		wrongShape, err := NewTensorValue(runtime, make([]float32, 5), []int64{1, 5})
+		if err != nil {
+			t.Fatalf("Failed to create tensor: %v", err)
+		}
+		defer wrongShape.Close()
+
+		var dimErr *DimensionMismatchError
+		if _, err := prepared.Run(t.Context(), []*Value{wrongShape}); !errors.As(err, &dimErr) {
+			t.Errorf("Expected DimensionMismatchError, got: %v", err)
+		}
+	})
+
+	t.Run("Closed", func(t *testing.T) {
+		prepared, err := session.Prepare([]string{"input"}, nil)
+		if err != nil {
+			t.Fatalf("Failed to prepare run: %v", err)
+		}
+		prepared.Close()
+
+		_, err = prepared.Run(t.Context(), []*Value{inputTensor})
+		if !errors.Is(err, ErrPreparedRunClosed) {
+			t.Errorf("Expected ErrPreparedRunClosed, got: %v", err)
+		}
+	})
+}
+
+func TestPreparedRunWithoutInputs(t *testing.T) {
+	runtime := newTestRuntime(t)
+	// The only input of optional.onnx is optional, so it can be left out
+	session := newTestSessionFromFile(t, runtime, testDataPath("optional.onnx"), nil)
+
+	prepared, err := session.Prepare(nil, nil)
+	if err != nil {
+		t.Fatalf("Failed to prepare run: %v", err)
+	}
+	defer prepared.Close()
+
+	outputs, err := prepared.Run(t.Context(), nil)
+	if err != nil {
+		t.Fatalf("Failed to run inference: %v", err)
+	}
+	defer outputs[0].Close()
+
+	assertTensorData(t, outputs[0], []bool{false}, []int64{})
+}
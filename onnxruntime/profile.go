@@ -0,0 +1,149 @@
+package onnxruntime
+
+import (
+	"cmp"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"slices"
+	"strings"
+	"time"
+)
+
+// kernelTimeSuffix is the suffix of profiling events that measure node execution time.
+const kernelTimeSuffix = "_kernel_time"
+
+// Profile is a summary of an ONNX Runtime profiling trace.
+type Profile struct {
+	// Nodes holds per-node latency summaries, sorted by total duration in descending order.
+	Nodes []NodeProfile
+
+	// OpTypes holds per-operator-type latency summaries, sorted by total duration
+	// in descending order.
+	OpTypes []OpTypeProfile
+}
+
+// NodeProfile summarizes the execution time of a single graph node.
+type NodeProfile struct {
+	// Name is the node name.
+	Name string
+	// OpType is the operator type of the node (e.g. "Conv").
+	OpType string
+	// Provider is the execution provider that ran the node.
+	Provider string
+	// Count is the number of times the node was executed.
+	Count int
+	// Total is the total execution time of the node.
+	Total time.Duration
+}
+
+// Mean returns the mean execution time of the node.
+func (p NodeProfile) Mean() time.Duration {
+	if p.Count == 0 {
+		return 0
+	}
+	return p.Total / time.Duration(p.Count)
+}
+
+// OpTypeProfile summarizes the execution time of all nodes of an operator type.
+type OpTypeProfile struct {
+	// OpType is the operator type (e.g. "Conv").
+	OpType string
+	// Count is the number of node executions of this operator type.
+	Count int
+	// Total is the total execution time of all nodes of this operator type.
+	Total time.Duration
+}
+
+// Mean returns the mean execution time of a node of this operator type.
+func (p OpTypeProfile) Mean() time.Duration {
+	if p.Count == 0 {
+		return 0
+	}
+	return p.Total / time.Duration(p.Count)
+}
+
+// profileEvent is a single event in the Chrome trace JSON written by ONNX Runtime.
+type profileEvent struct {
+	Category string `json:"cat"`
+	Name     string `json:"name"`
+	Duration int64  `json:"dur"` // microseconds
+	Args     struct {
+		OpName   string `json:"op_name"`
+		Provider string `json:"provider"`
+	} `json:"args"`
+}
+
+// ParseProfileFile parses the profile file written by ONNX Runtime.
+// See ParseProfile for details.
+func ParseProfileFile(path string) (*Profile, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open profile file: %w", err)
+	}
+	defer f.Close()
+
+	return ParseProfile(f)
+}
+
+// ParseProfile parses a profiling trace in the Chrome trace JSON format written
+// by ONNX Runtime and summarizes the kernel execution time per node and per
+// operator type.
+func ParseProfile(r io.Reader) (*Profile, error) {
+	var events []profileEvent
+	if err := json.NewDecoder(r).Decode(&events); err != nil {
+		return nil, fmt.Errorf("failed to decode profile: %w", err)
+	}
+
+	nodes := make(map[string]*NodeProfile)
+	opTypes := make(map[string]*OpTypeProfile)
+	for _, event := range events {
+		if event.Category != "Node" || !strings.HasSuffix(event.Name, kernelTimeSuffix) {
+			continue
+		}
+
+		duration := time.Duration(event.Duration) * time.Microsecond
+
+		name := strings.TrimSuffix(event.Name, kernelTimeSuffix)
+		node, ok := nodes[name]
+		if !ok {
+			node = &NodeProfile{
+				Name:     name,
+				OpType:   event.Args.OpName,
+				Provider: event.Args.Provider,
+			}
+			nodes[name] = node
+		}
+		node.Count++
+		node.Total += duration
+
+		opType, ok := opTypes[event.Args.OpName]
+		if !ok {
+			opType = &OpTypeProfile{OpType: event.Args.OpName}
+			opTypes[event.Args.OpName] = opType
+		}
+		opType.Count++
+		opType.Total += duration
+	}
+
+	profile := &Profile{
+		Nodes:   make([]NodeProfile, 0, len(nodes)),
+		OpTypes: make([]OpTypeProfile, 0, len(opTypes)),
+	}
+	for _, node := range nodes {
+		profile.Nodes = append(profile.Nodes, *node)
+	}
+	for _, opType := range opTypes {
+		profile.OpTypes = append(profile.OpTypes, *opType)
+	}
+
+	slices.SortFunc(profile.Nodes, func(a, b NodeProfile) int {
+		return cmp.Or(cmp.Compare(b.Total, a.Total), cmp.Compare(a.Name, b.Name))
+	})
+	slices.SortFunc(profile.OpTypes, func(a, b OpTypeProfile) int {
+		return cmp.Or(cmp.Compare(b.Total, a.Total), cmp.Compare(a.OpType, b.OpType))
+	})
+
+	return profile, nil
+}
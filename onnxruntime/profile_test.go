@@ -0,0 +1,102 @@
+package onnxruntime
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+const testProfileJSON = `[
+{"cat" : "Session","pid" :1,"tid" :1,"dur" :120,"ts" :1,"ph" : "X","name" :"model_loading_array","args" : {}},
+{"cat" : "Node","pid" :1,"tid" :1,"dur" :1,"ts" :10,"ph" : "X","name" :"node_linear_fence_before","args" : {"op_name" : "Gemm"}},
+{"cat" : "Node","pid" :1,"tid" :1,"dur" :30,"ts" :11,"ph" : "X","name" :"node_linear_kernel_time","args" : {"thread_scheduling_stats" : "","output_size" : "12","parameter_size" : "132","activation_size" : "40","output_type_shape" : [{"float":[1,3]}],"exec_plan_index" : "0","graph_index" : "0","input_type_shape" : [{"float":[1,10]}],"provider" : "CPUExecutionProvider","op_name" : "Gemm"}},
+{"cat" : "Node","pid" :1,"tid" :1,"dur" :5,"ts" :41,"ph" : "X","name" :"node_relu_kernel_time","args" : {"provider" : "CPUExecutionProvider","op_name" : "Relu"}},
+{"cat" : "Node","pid" :1,"tid" :1,"dur" :50,"ts" :50,"ph" : "X","name" :"node_linear_kernel_time","args" : {"provider" : "CPUExecutionProvider","op_name" : "Gemm"}},
+{"cat" : "Node","pid" :1,"tid" :1,"dur" :7,"ts" :100,"ph" : "X","name" :"node_linear2_kernel_time","args" : {"provider" : "CPUExecutionProvider","op_name" : "Gemm"}}
+]`
+
+func TestParseProfile(t *testing.T) {
+	profile, err := ParseProfile(strings.NewReader(testProfileJSON))
+	if err != nil {
+		t.Fatalf("Failed to parse profile: %v", err)
+	}
+
+	if len(profile.Nodes) != 3 {
+		t.Fatalf("Expected 3 nodes, got %d", len(profile.Nodes))
+	}
+	node := profile.Nodes[0]
+	if node.Name != "node_linear" || node.OpType != "Gemm" || node.Provider != "CPUExecutionProvider" {
+		t.Errorf("Unexpected slowest node: %+v", node)
+	}
+	if node.Count != 2 || node.Total != 80*time.Microsecond || node.Mean() != 40*time.Microsecond {
+		t.Errorf("Unexpected node timing: %+v", node)
+	}
+
+	if len(profile.OpTypes) != 2 {
+		t.Fatalf("Expected 2 op types, got %d", len(profile.OpTypes))
+	}
+	if opType := profile.OpTypes[0]; opType.OpType != "Gemm" || opType.Count != 3 || opType.Total != 87*time.Microsecond {
+		t.Errorf("Unexpected Gemm summary: %+v", opType)
+	}
+	if opType := profile.OpTypes[1]; opType.OpType != "Relu" || opType.Count != 1 || opType.Total != 5*time.Microsecond {
+		t.Errorf("Unexpected Relu summary: %+v", opType)
+	}
+}
+
+func TestParseProfileWithInvalidJSON(t *testing.T) {
+	_, err := ParseProfile(strings.NewReader("{"))
+	if err == nil {
+		t.Error("Expected error when parsing invalid profile")
+	}
+}
+
+func TestSessionEndProfiling(t *testing.T) {
+	runtime := newTestRuntime(t)
+
+	env, err := runtime.NewEnv("test", LoggingLevelWarning)
+	if err != nil {
+		t.Fatalf("Failed to create environment: %v", err)
+	}
+	defer env.Close()
+
+	opts := &SessionOptions{
+		ProfileFilePrefix: filepath.Join(t.TempDir(), "profile"),
+	}
+	session, err := runtime.NewSession(env, testModelPath(), opts)
+	if err != nil {
+		t.Fatalf("Failed to create session: %v", err)
+	}
+	defer session.Close()
+
+	inputTensor, err := NewTensorValue(runtime, make([]float32, 10), []int64{1, 10})
+	if err != nil {
+		t.Fatalf("Failed to create input tensor: %v", err)
+	}
+	defer inputTensor.Close()
+
+	outputs, err := session.Run(t.Context(), map[string]*Value{
+		"input": inputTensor,
+	})
+	if err != nil {
+		t.Fatalf("Failed to run inference: %v", err)
+	}
+	for _, output := range outputs {
+		output.Close()
+	}
+
+	profilePath, err := session.EndProfiling()
+	if err != nil {
+		t.Fatalf("Failed to end profiling: %v", err)
+	}
+	defer os.Remove(profilePath)
+
+	profile, err := ParseProfileFile(profilePath)
+	if err != nil {
+		t.Fatalf("Failed to parse profile file: %v", err)
+	}
+	if len(profile.Nodes) == 0 {
+		t.Error("Expected at least one node in the profile")
+	}
+}
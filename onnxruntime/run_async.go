@@ -0,0 +1,176 @@
+package onnxruntime
+
+import (
+	"context"
+	"fmt"
+	"runtime"
+	"sync"
+	"sync/atomic"
+
+	"github.com/ebitengine/purego"
+	"github.com/shota3506/onnxruntime-purego/onnxruntime/internal/api"
+)
+
+// Result is the outcome of an asynchronous inference run started by RunAsync.
+type Result struct {
+	// Outputs maps output names to the computed values. It is nil if Err is set.
+	Outputs map[string]*Value
+	// Err is the error of the run, if any.
+	Err error
+}
+
+// asyncRun tracks an inference run scheduled by RunAsync until its
+// completion callback is invoked (internal use)
+type asyncRun struct {
+	ctx     context.Context
+	session *Session
+	req     *runRequest
+	args    *runArgs
+	pinner  runtime.Pinner
+	release func()
+	result  chan Result
+}
+
+var (
+	// runAsyncCallback is the native completion callback shared by all
+	// asynchronous runs. purego callbacks cannot be freed, so it is created once.
+	runAsyncCallback     uintptr
+	runAsyncCallbackOnce sync.Once
+
+	// pendingRuns maps the user data passed to RunAsync to the run it belongs to.
+	// An ID is passed instead of a Go pointer, which must not be retained by native code.
+	pendingRuns    sync.Map // map[uintptr]*asyncRun
+	nextAsyncRunID atomic.Uintptr
+)
+
+// getRunAsyncCallback returns the native completion callback for RunAsync.
+func getRunAsyncCallback() uintptr {
+	runAsyncCallbackOnce.Do(func() {
+		runAsyncCallback = purego.NewCallback(onRunAsyncComplete)
+	})
+	return runAsyncCallback
+}
+
+// onRunAsyncComplete is invoked by ONNX Runtime on one of its intra-op threads
+// when an asynchronous run completes. The outputs are read from the output array
+// passed to RunAsync, which ONNX Runtime fills in place.
+func onRunAsyncComplete(userData uintptr, outputs uintptr, numOutputs uintptr, status uintptr) {
+	v, ok := pendingRuns.LoadAndDelete(userData)
+	if !ok {
+		return
+	}
+	run := v.(*asyncRun)
+	run.complete(api.OrtStatus(status))
+}
+
+// complete releases the resources held for the run and delivers its result.
+func (run *asyncRun) complete(status api.OrtStatus) {
+	defer close(run.result)
+	defer run.pinner.Unpin()
+	defer run.release()
+
+	s := run.session
+	if err := s.runtime.statusError(status, "RunAsync"); err != nil {
+		err = fmt.Errorf("failed to run inference: %w", err)
+		if ctxErr := run.ctx.Err(); ctxErr != nil {
+			err = fmt.Errorf("%w: %w", ctxErr, err)
+		}
+		run.result <- Result{Err: err}
+		return
+	}
+
+	outputValues := s.wrapOutputs(run.args.outputs, run.req.outputs)
+	run.result <- Result{Outputs: run.req.outputMap(outputValues)}
+}
+
+// RunAsync starts executing the model with the provided inputs and returns
+// immediately. The result is delivered on the returned channel, which receives
+// exactly one Result and is then closed. No goroutine is blocked while the
+// model runs; ONNX Runtime executes it on the session's intra-op thread pool.
+//
+// The session must use an intra-op thread pool with at least two threads
+// (see SessionOptions.IntraOpNumThreads), otherwise the result reports an error.
+// The session, the inputs and any preallocated outputs must not be closed
+// until the result has been received.
+//
+// If ctx is cancelled or its deadline expires while the model is running, the
+// inference is terminated and the error of the result wraps both ctx.Err() and
+// the RuntimeError reported by ONNX Runtime.
+func (s *Session) RunAsync(ctx context.Context, inputs map[string]*Value, opts ...RunOption) <-chan Result {
+	result := make(chan Result, 1)
+	if err := s.runAsync(ctx, inputs, opts, result); err != nil {
+		result <- Result{Err: err}
+		close(result)
+	}
+	return result
+}
+
+// runAsync schedules an asynchronous run whose result is sent on result.
+// On error, nothing is sent and the run is not scheduled.
+func (s *Session) runAsync(ctx context.Context, inputs map[string]*Value, opts []RunOption, result chan Result) error {
+	if s.ptr == 0 {
+		return ErrSessionClosed
+	}
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	req, err := s.newRunRequest(inputs, opts)
+	if err != nil {
+		return err
+	}
+	args, err := newRunArgs(req.inputNames, req.inputs, req.config.outputNames, req.outputs)
+	if err != nil {
+		return err
+	}
+
+	runOptionsPtr, releaseRunOptions, err := s.runtime.prepareRunOptions(ctx, req.config)
+	if err != nil {
+		return err
+	}
+
+	run := &asyncRun{
+		ctx:     ctx,
+		session: s,
+		req:     req,
+		args:    args,
+		release: releaseRunOptions,
+		result:  result,
+	}
+
+	// ONNX Runtime keeps referencing the argument arrays until the run completes
+	for _, name := range args.inputNames {
+		run.pinner.Pin(name)
+	}
+	for _, name := range args.outputNames {
+		run.pinner.Pin(name)
+	}
+	pinSlice(&run.pinner, args.inputNames)
+	pinSlice(&run.pinner, args.inputs)
+	pinSlice(&run.pinner, args.outputNames)
+	pinSlice(&run.pinner, args.outputs)
+
+	id := nextAsyncRunID.Add(1)
+	pendingRuns.Store(id, run)
+
+	status := s.runtime.apiFuncs.RunAsync(
+		s.ptr,
+		runOptionsPtr,
+		firstElement(args.inputNames),
+		firstElement(args.inputs),
+		uintptr(len(args.inputs)),
+		firstElement(args.outputNames),
+		uintptr(len(args.outputNames)),
+		firstElement(args.outputs),
+		getRunAsyncCallback(),
+		id,
+	)
+	if err := s.runtime.statusError(status, "RunAsync"); err != nil {
+		pendingRuns.Delete(id)
+		run.pinner.Unpin()
+		releaseRunOptions()
+		return fmt.Errorf("failed to run inference asynchronously: %w", err)
+	}
+
+	return nil
+}
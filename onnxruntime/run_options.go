@@ -0,0 +1,143 @@
+package onnxruntime
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"slices"
+
+	"github.com/shota3506/onnxruntime-purego/onnxruntime/internal/api"
+)
+
+// runOptions represents ONNX Runtime run options (internal use only)
+type runOptions struct {
+	ptr     api.OrtRunOptions
+	runtime *Runtime
+}
+
+// newRunOptions creates a new run options handle configured from config (internal use)
+func (r *Runtime) newRunOptions(config *runConfig) (*runOptions, error) {
+	var ptr api.OrtRunOptions
+	status := r.apiFuncs.CreateRunOptions(&ptr)
+	if err := r.statusError(status, "CreateRunOptions"); err != nil {
+		return nil, fmt.Errorf("failed to create run options: %w", err)
+	}
+
+	ro := &runOptions{
+		ptr:     ptr,
+		runtime: r,
+	}
+	if err := ro.configure(config); err != nil {
+		ro.release()
+		return nil, err
+	}
+	return ro, nil
+}
+
+// prepareRunOptions creates run options for config when they are needed, i.e.
+// when ctx can be cancelled or config sets any run option. Once ctx is done, the
+// run using the options is terminated. The returned release function must be
+// called after the run completes (internal use)
+func (r *Runtime) prepareRunOptions(ctx context.Context, config *runConfig) (api.OrtRunOptions, func(), error) {
+	if ctx.Done() == nil && !config.hasRunOptions() {
+		return 0, func() {}, nil
+	}
+
+	ro, err := r.newRunOptions(config)
+	if err != nil {
+		return 0, nil, err
+	}
+	if ctx.Done() == nil {
+		return ro.ptr, ro.release, nil
+	}
+
+	// Terminate the inference when the context is done
+	stop := ro.watchContext(ctx)
+	return ro.ptr, func() {
+		stop()
+		ro.release()
+	}, nil
+}
+
+// configure applies the run configuration to the run options (internal use)
+func (ro *runOptions) configure(config *runConfig) error {
+	r := ro.runtime
+
+	if config.runTag != "" {
+		tagBytes := append([]byte(config.runTag), 0)
+		status := r.apiFuncs.RunOptionsSetRunTag(ro.ptr, &tagBytes[0])
+		if err := r.statusError(status, "RunOptionsSetRunTag"); err != nil {
+			return fmt.Errorf("failed to set run tag: %w", err)
+		}
+	}
+
+	if config.logSeverityLevel != nil {
+		status := r.apiFuncs.RunOptionsSetRunLogSeverityLevel(ro.ptr, int32(*config.logSeverityLevel))
+		if err := r.statusError(status, "RunOptionsSetRunLogSeverityLevel"); err != nil {
+			return fmt.Errorf("failed to set run log severity level: %w", err)
+		}
+	}
+
+	if config.logVerbosityLevel != nil {
+		status := r.apiFuncs.RunOptionsSetRunLogVerbosityLevel(ro.ptr, int32(*config.logVerbosityLevel))
+		if err := r.statusError(status, "RunOptionsSetRunLogVerbosityLevel"); err != nil {
+			return fmt.Errorf("failed to set run log verbosity level: %w", err)
+		}
+	}
+
+	for _, key := range slices.Sorted(maps.Keys(config.configEntries)) {
+		keyBytes := append([]byte(key), 0)
+		valueBytes := append([]byte(config.configEntries[key]), 0)
+		status := r.apiFuncs.AddRunConfigEntry(ro.ptr, &keyBytes[0], &valueBytes[0])
+		if err := r.statusError(status, "AddRunConfigEntry"); err != nil {
+			return fmt.Errorf("failed to add run config entry %q: %w", key, err)
+		}
+	}
+
+	return nil
+}
+
+// setTerminate requests all currently executing Run calls using these
+// options to terminate as soon as possible (internal use)
+func (ro *runOptions) setTerminate() error {
+	status := ro.runtime.apiFuncs.RunOptionsSetTerminate(ro.ptr)
+	if err := ro.runtime.statusError(status, "RunOptionsSetTerminate"); err != nil {
+		return fmt.Errorf("failed to set terminate flag: %w", err)
+	}
+	return nil
+}
+
+// unsetTerminate clears the terminate flag so the run options can be used
+// by subsequent Run calls (internal use)
+func (ro *runOptions) unsetTerminate() error {
+	status := ro.runtime.apiFuncs.RunOptionsUnsetTerminate(ro.ptr)
+	if err := ro.runtime.statusError(status, "RunOptionsUnsetTerminate"); err != nil {
+		return fmt.Errorf("failed to unset terminate flag: %w", err)
+	}
+	return nil
+}
+
+// watchContext sets the terminate flag on the run options once ctx is done.
+// The returned function stops watching and waits for a pending terminate
+// request to complete, so the run options can be released safely afterwards.
+func (ro *runOptions) watchContext(ctx context.Context) (stop func()) {
+	done := make(chan struct{})
+	stopAfterFunc := context.AfterFunc(ctx, func() {
+		defer close(done)
+		_ = ro.setTerminate()
+	})
+
+	return func() {
+		if !stopAfterFunc() {
+			<-done
+		}
+	}
+}
+
+// release releases the run options (internal use)
+func (ro *runOptions) release() {
+	if ro.ptr != 0 && ro.runtime != nil && ro.runtime.apiFuncs != nil {
+		ro.runtime.apiFuncs.ReleaseRunOptions(ro.ptr)
+		ro.ptr = 0
+	}
+}
@@ -42,7 +42,7 @@ type Runtime struct {
 
 	// Default allocator and memory info
 	allocator     *allocator
-	cpuMemoryInfo *memoryInfo
+	cpuMemoryInfo *MemoryInfo
 }
 
 // NewRuntime loads the ONNX Runtime shared library from the specified path and
@@ -126,7 +126,7 @@ func (r *Runtime) initializeV23API() error {
 func (r *Runtime) initializeAllocator() error {
 	var allocPtr api.OrtAllocator
 	status := r.apiFuncs.GetAllocatorWithDefaultOptions(&allocPtr)
-	if err := r.statusError(status); err != nil {
+	if err := r.statusError(status, "GetAllocatorWithDefaultOptions"); err != nil {
 		return fmt.Errorf("failed to get default allocator: %w", err)
 	}
 
@@ -139,7 +139,7 @@ func (r *Runtime) initializeAllocator() error {
 
 // initializeMemoryInfo initializes the default CPU memory info for this runtime.
 func (r *Runtime) initializeMemoryInfo() error {
-	memInfo, err := r.createCPUMemoryInfo(allocatorTypeDevice, memTypeCPU)
+	memInfo, err := r.createCPUMemoryInfo(allocatorTypeDevice, memTypeDefault)
 	if err != nil {
 		return fmt.Errorf("failed to create CPU memory info: %w", err)
 	}
@@ -148,14 +148,14 @@ func (r *Runtime) initializeMemoryInfo() error {
 }
 
 // createCPUMemoryInfo creates memory info for CPU.
-func (r *Runtime) createCPUMemoryInfo(allocType allocatorType, memType memType) (*memoryInfo, error) {
+func (r *Runtime) createCPUMemoryInfo(allocType allocatorType, memType memType) (*MemoryInfo, error) {
 	var memInfoPtr api.OrtMemoryInfo
 	status := r.apiFuncs.CreateCpuMemoryInfo(allocType, memType, &memInfoPtr)
-	if err := r.statusError(status); err != nil {
+	if err := r.statusError(status, "CreateCpuMemoryInfo"); err != nil {
 		return nil, fmt.Errorf("failed to create CPU memory info: %w", err)
 	}
 
-	return &memoryInfo{
+	return &MemoryInfo{
 		ptr:     memInfoPtr,
 		runtime: r,
 	}, nil
@@ -167,7 +167,7 @@ func (r *Runtime) createCPUMemoryInfo(allocType allocatorType, memType memType)
 func (r *Runtime) Close() error {
 	// Release default memory info
 	if r.cpuMemoryInfo != nil {
-		r.cpuMemoryInfo.release()
+		r.cpuMemoryInfo.Close()
 		r.cpuMemoryInfo = nil
 	}
 
@@ -194,8 +194,8 @@ func (r *Runtime) GetVersionString() string {
 	return r.versionString
 }
 
-// statusError converts an OrtStatus to a Go error.
-func (r *Runtime) statusError(status api.OrtStatus) error {
+// statusError converts an OrtStatus returned by the C API function op to a Go error.
+func (r *Runtime) statusError(status api.OrtStatus, op string) error {
 	if status == 0 {
 		return nil
 	}
@@ -210,6 +210,7 @@ func (r *Runtime) statusError(status api.OrtStatus) error {
 	return &RuntimeError{
 		Code:    code,
 		Message: message,
+		Op:      op,
 	}
 }
 
@@ -220,7 +221,7 @@ func (r *Runtime) GetAvailableProviders() ([]string, error) {
 	var providersPtr **byte
 	var length int32
 	status := r.apiFuncs.GetAvailableProviders(&providersPtr, &length)
-	if err := r.statusError(status); err != nil {
+	if err := r.statusError(status, "GetAvailableProviders"); err != nil {
 		return nil, fmt.Errorf("failed to get available providers: %w", err)
 	}
 
@@ -234,7 +235,7 @@ func (r *Runtime) GetAvailableProviders() ([]string, error) {
 
 	// Release the allocated provider list
 	status = r.apiFuncs.ReleaseAvailableProviders(providersPtr, length)
-	if err := r.statusError(status); err != nil {
+	if err := r.statusError(status, "ReleaseAvailableProviders"); err != nil {
 		return nil, fmt.Errorf("failed to release available providers: %w", err)
 	}
 
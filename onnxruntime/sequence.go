@@ -0,0 +1,44 @@
+package onnxruntime
+
+import (
+	"fmt"
+)
+
+// NewSequenceValue creates a new sequence value from a slice of values.
+// The values must not be empty and must all have the same type, either tensors
+// of the same element type or maps of the same key and value types.
+// The given values remain owned by the caller and can be closed independently
+// of the sequence. The sequence shares the element buffers, so Go memory
+// referenced by the elements stays pinned until the sequence is also closed.
+func NewSequenceValue(r *Runtime, values []*Value) (*Value, error) {
+	if len(values) == 0 {
+		return nil, fmt.Errorf("values cannot be empty")
+	}
+
+	value, err := r.newValueFromValues(values, ONNXTypeSequence)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create sequence: %w", err)
+	}
+	return value, nil
+}
+
+// SequenceLen returns the number of elements in a sequence value.
+func (v *Value) SequenceLen() (int, error) {
+	if err := v.checkValueType(ONNXTypeSequence); err != nil {
+		return 0, err
+	}
+	return v.getValueCount()
+}
+
+// SequenceAt returns the element at index i of a sequence value.
+// The returned value is a new value owned by the caller, which must close it.
+func (v *Value) SequenceAt(i int) (*Value, error) {
+	n, err := v.SequenceLen()
+	if err != nil {
+		return nil, err
+	}
+	if i < 0 || i >= n {
+		return nil, fmt.Errorf("sequence index %d out of range [0, %d)", i, n)
+	}
+	return v.getValue(i)
+}
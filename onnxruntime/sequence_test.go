@@ -0,0 +1,170 @@
+package onnxruntime
+
+import (
+	"testing"
+)
+
+func TestNewSequenceValue(t *testing.T) {
+	runtime := newTestRuntime(t)
+
+	t.Run("Tensors", func(t *testing.T) {
+		first, err := NewTensorValue(runtime, []float32{1.0, 2.0, 3.0}, []int64{3})
+		if err != nil {
+			t.Fatalf("Failed to create tensor: %v", err)
+		}
+		defer first.Close()
+
+		second, err := NewTensorValue(runtime, []float32{4.0, 5.0}, []int64{1, 2})
+		if err != nil {
+			t.Fatalf("Failed to create tensor: %v", err)
+		}
+		defer second.Close()
+
+		sequence, err := NewSequenceValue(runtime, []*Value{first, second})
+		if err != nil {
+			t.Fatalf("Failed to create sequence: %v", err)
+		}
+		defer sequence.Close()
+
+		valueType, err := sequence.GetValueType()
+		if err != nil {
+			t.Fatalf("Failed to get value type: %v", err)
+		}
+		if valueType != ONNXTypeSequence {
+			t.Errorf("Expected value type to be ONNXTypeSequence, got %d", valueType)
+		}
+
+		length, err := sequence.SequenceLen()
+		if err != nil {
+			t.Fatalf("Failed to get sequence length: %v", err)
+		}
+		if length != 2 {
+			t.Fatalf("Expected sequence length 2, got %d", length)
+		}
+
+		element, err := sequence.SequenceAt(1)
+		if err != nil {
+			t.Fatalf("Failed to get sequence element: %v", err)
+		}
+		defer element.Close()
+
+		assertTensorData(t, element, []float32{4.0, 5.0}, []int64{1, 2})
+	})
+
+	t.Run("ElementsClosedFirst", func(t *testing.T) {
+		first, err := NewTensorValue(runtime, []float32{1.0, 2.0, 3.0}, []int64{3})
+		if err != nil {
+			t.Fatalf("Failed to create tensor: %v", err)
+		}
+		second, err := NewTensorValue(runtime, []float32{4.0, 5.0}, []int64{2})
+		if err != nil {
+			first.Close()
+			t.Fatalf("Failed to create tensor: %v", err)
+		}
+
+		sequence, err := NewSequenceValue(runtime, []*Value{first, second})
+		first.Close()
+		second.Close()
+		if err != nil {
+			t.Fatalf("Failed to create sequence: %v", err)
+		}
+		defer sequence.Close()
+
+		// The sequence shares the element buffers, so the Go memory must
+		// stay pinned and reachable after the elements are closed.
+		if len(sequence.pinned) != 2 {
+			t.Fatalf("Expected sequence to reference 2 element buffers, got %d", len(sequence.pinned))
+		}
+
+		for i, expected := range [][]float32{{1.0, 2.0, 3.0}, {4.0, 5.0}} {
+			element, err := sequence.SequenceAt(i)
+			if err != nil {
+				t.Fatalf("Failed to get sequence element %d: %v", i, err)
+			}
+			assertTensorData(t, element, expected, []int64{int64(len(expected))})
+			element.Close()
+		}
+	})
+
+	t.Run("Maps", func(t *testing.T) {
+		m, err := NewMapValue(runtime, map[int64]float32{0: 0.25, 1: 0.75})
+		if err != nil {
+			t.Fatalf("Failed to create map: %v", err)
+		}
+		defer m.Close()
+
+		sequence, err := NewSequenceValue(runtime, []*Value{m})
+		if err != nil {
+			t.Fatalf("Failed to create sequence: %v", err)
+		}
+		defer sequence.Close()
+
+		element, err := sequence.SequenceAt(0)
+		if err != nil {
+			t.Fatalf("Failed to get sequence element: %v", err)
+		}
+		defer element.Close()
+
+		data, err := GetMapData[int64, float32](element)
+		if err != nil {
+			t.Fatalf("Failed to get map data: %v", err)
+		}
+		if len(data) != 2 || data[0] != 0.25 || data[1] != 0.75 {
+			t.Errorf("Unexpected map data: %v", data)
+		}
+	})
+
+	t.Run("EmptyValues", func(t *testing.T) {
+		_, err := NewSequenceValue(runtime, nil)
+		if err == nil {
+			t.Error("Expected error when creating sequence with no values")
+		}
+	})
+
+	t.Run("ClosedValue", func(t *testing.T) {
+		tensor, err := NewTensorValue(runtime, []float32{1.0}, []int64{1})
+		if err != nil {
+			t.Fatalf("Failed to create tensor: %v", err)
+		}
+		tensor.Close()
+
+		_, err = NewSequenceValue(runtime, []*Value{tensor})
+		if err == nil {
+			t.Error("Expected error when creating sequence with a closed value")
+		}
+	})
+}
+
+func TestValueSequenceAt(t *testing.T) {
+	runtime := newTestRuntime(t)
+
+	tensor, err := NewTensorValue(runtime, []int64{1, 2, 3}, []int64{3})
+	if err != nil {
+		t.Fatalf("Failed to create tensor: %v", err)
+	}
+	defer tensor.Close()
+
+	t.Run("OutOfRange", func(t *testing.T) {
+		sequence, err := NewSequenceValue(runtime, []*Value{tensor})
+		if err != nil {
+			t.Fatalf("Failed to create sequence: %v", err)
+		}
+		defer sequence.Close()
+
+		if _, err := sequence.SequenceAt(1); err == nil {
+			t.Error("Expected error for index out of range")
+		}
+		if _, err := sequence.SequenceAt(-1); err == nil {
+			t.Error("Expected error for negative index")
+		}
+	})
+
+	t.Run("NotSequence", func(t *testing.T) {
+		if _, err := tensor.SequenceLen(); err == nil {
+			t.Error("Expected error when getting sequence length of a tensor")
+		}
+		if _, err := tensor.SequenceAt(0); err == nil {
+			t.Error("Expected error when getting sequence element of a tensor")
+		}
+	})
+}
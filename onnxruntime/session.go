@@ -5,6 +5,8 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"maps"
+	"slices"
 	"unsafe"
 
 	"github.com/shota3506/onnxruntime-purego/internal/cstrings"
@@ -17,10 +19,57 @@ type SessionOptions struct {
 	// execution within nodes. A value of 0 uses the default number of threads.
 	IntraOpNumThreads int
 
+	// InterOpNumThreads sets the number of threads used for parallelizing
+	// execution of the graph across nodes. It only takes effect with
+	// ExecutionModeParallel. A value of 0 uses the default number of threads.
+	InterOpNumThreads int
+
+	// GraphOptimizationLevel sets the level of graph optimizations applied to the model.
+	// If unset, all optimizations are enabled.
+	GraphOptimizationLevel GraphOptimizationLevel
+
+	// ExecutionMode sets whether operators are executed sequentially or in parallel.
+	// The default is ExecutionModeSequential.
+	ExecutionMode ExecutionMode
+
+	// DisableMemoryPattern disables memory pattern optimization, which pre-allocates
+	// memory based on the shapes seen in previous runs.
+	DisableMemoryPattern bool
+
+	// DisableCPUMemoryArena disables the memory arena on CPU.
+	DisableCPUMemoryArena bool
+
+	// DeterministicCompute makes kernels use deterministic implementations where available.
+	DeterministicCompute bool
+
+	// OptimizedModelFilePath is the file path where the optimized model is saved
+	// when the session is created. The model is saved in ORT format if the path
+	// ends with ".ort", and in ONNX format otherwise. The format can also be set
+	// explicitly with the "session.save_model_format" config entry.
+	OptimizedModelFilePath string
+
+	// ProfileFilePrefix enables profiling when set. The profile is written in
+	// Chrome trace JSON format to a file whose name starts with this prefix.
+	// Call Session.EndProfiling to finish profiling and get the file path.
+	ProfileFilePrefix string
+
+	// ConfigEntries sets session configuration entries as key/value pairs,
+	// for example "session.intra_op.allow_spinning" or "session.load_model_format".
+	// See onnxruntime_session_options_config_keys.h for the available keys.
+	ConfigEntries map[string]string
+
 	// ExecutionProviders specifies the execution providers to use, in order of preference.
-	// Common values include "CPUExecutionProvider", "CUDAExecutionProvider", etc.
 	// If empty, the default provider(s) will be used.
-	ExecutionProviders []string
+	ExecutionProviders []ExecutionProvider
+}
+
+// ExecutionProvider specifies an execution provider and its provider-specific options.
+type ExecutionProvider struct {
+	// Name is the provider name, such as "XNNPACK", "CoreML", "OpenVINO" or "QNN".
+	Name string
+
+	// Options holds provider-specific options as key/value pairs.
+	Options map[string]string
 }
 
 // Session represents an ONNX Runtime inference session that can execute
@@ -32,6 +81,8 @@ type Session struct {
 	// metadata
 	inputNames  []string
 	outputNames []string
+	inputInfo   []TensorInfo
+	outputInfo  []TensorInfo
 }
 
 // NewSession creates a new inference session from a model file.
@@ -39,7 +90,7 @@ func (r *Runtime) NewSession(env *Env, modelPath string, options *SessionOptions
 	var optsPtr api.OrtSessionOptions
 	if options != nil {
 		status := r.apiFuncs.CreateSessionOptions(&optsPtr)
-		if err := r.statusError(status); err != nil {
+		if err := r.statusError(status, "CreateSessionOptions"); err != nil {
 			return nil, fmt.Errorf("failed to create session options: %w", err)
 		}
 		defer func() {
@@ -48,11 +99,8 @@ func (r *Runtime) NewSession(env *Env, modelPath string, options *SessionOptions
 			}
 		}()
 
-		if err := r.configureIntraOpNumThreads(optsPtr, options); err != nil {
-			return nil, fmt.Errorf("failed to configure intra-op num threads: %w", err)
-		}
-		if err := r.configureExecutionProviders(optsPtr, options); err != nil {
-			return nil, fmt.Errorf("failed to configure execution providers: %w", err)
+		if err := r.configureSessionOptions(optsPtr, options); err != nil {
+			return nil, err
 		}
 	}
 
@@ -60,7 +108,7 @@ func (r *Runtime) NewSession(env *Env, modelPath string, options *SessionOptions
 	var sessionPtr api.OrtSession
 
 	status := r.apiFuncs.CreateSession(env.ptr, &modelPathBytes[0], api.OrtSessionOptions(optsPtr), &sessionPtr)
-	if err := r.statusError(status); err != nil {
+	if err := r.statusError(status, "CreateSession"); err != nil {
 		return nil, fmt.Errorf("failed to create session: %w", err)
 	}
 
@@ -94,7 +142,7 @@ func (r *Runtime) NewSessionFromReader(env *Env, modelReader io.Reader, options
 	var optsPtr api.OrtSessionOptions
 	if options != nil {
 		status := r.apiFuncs.CreateSessionOptions(&optsPtr)
-		if err := r.statusError(status); err != nil {
+		if err := r.statusError(status, "CreateSessionOptions"); err != nil {
 			return nil, fmt.Errorf("failed to create session options: %w", err)
 		}
 		defer func() {
@@ -103,18 +151,15 @@ func (r *Runtime) NewSessionFromReader(env *Env, modelReader io.Reader, options
 			}
 		}()
 
-		if err := r.configureIntraOpNumThreads(optsPtr, options); err != nil {
-			return nil, fmt.Errorf("failed to configure intra-op num threads: %w", err)
-		}
-		if err := r.configureExecutionProviders(optsPtr, options); err != nil {
-			return nil, fmt.Errorf("failed to configure execution providers: %w", err)
+		if err := r.configureSessionOptions(optsPtr, options); err != nil {
+			return nil, err
 		}
 	}
 
 	var sessionPtr api.OrtSession
 
 	status := r.apiFuncs.CreateSessionFromArray(env.ptr, unsafe.Pointer(&modelData[0]), uintptr(len(modelData)), api.OrtSessionOptions(optsPtr), &sessionPtr)
-	if err := r.statusError(status); err != nil {
+	if err := r.statusError(status, "CreateSessionFromArray"); err != nil {
 		return nil, fmt.Errorf("failed to create session: %w", err)
 	}
 
@@ -132,6 +177,21 @@ func (r *Runtime) NewSessionFromReader(env *Env, modelReader io.Reader, options
 	return session, nil
 }
 
+// OptimizeModel applies graph optimizations at the given level to the model at
+// inputPath and saves the optimized model to outputPath. The output is saved in
+// ORT format if outputPath ends with ".ort", and in ONNX format otherwise.
+func (r *Runtime) OptimizeModel(env *Env, inputPath, outputPath string, level GraphOptimizationLevel) error {
+	session, err := r.NewSession(env, inputPath, &SessionOptions{
+		GraphOptimizationLevel: level,
+		OptimizedModelFilePath: outputPath,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to optimize model: %w", err)
+	}
+	session.Close()
+	return nil
+}
+
 // initializeMetadata caches input and output names during session creation
 func (s *Session) initializeMetadata() error {
 	// Get input count and names
@@ -141,12 +201,19 @@ func (s *Session) initializeMetadata() error {
 	}
 
 	s.inputNames = make([]string, inputCount)
+	s.inputInfo = make([]TensorInfo, inputCount)
 	for i := range inputCount {
 		name, err := s.getInputName(i)
 		if err != nil {
 			return fmt.Errorf("failed to get input name at index %d: %w", i, err)
 		}
 		s.inputNames[i] = name
+
+		info, err := s.getInputInfo(i, name)
+		if err != nil {
+			return fmt.Errorf("failed to get input type info at index %d: %w", i, err)
+		}
+		s.inputInfo[i] = info
 	}
 
 	// Get output count and names
@@ -156,12 +223,19 @@ func (s *Session) initializeMetadata() error {
 	}
 
 	s.outputNames = make([]string, outputCount)
+	s.outputInfo = make([]TensorInfo, outputCount)
 	for i := range outputCount {
 		name, err := s.getOutputName(i)
 		if err != nil {
 			return fmt.Errorf("failed to get output name at index %d: %w", i, err)
 		}
 		s.outputNames[i] = name
+
+		info, err := s.getOutputInfo(i, name)
+		if err != nil {
+			return fmt.Errorf("failed to get output type info at index %d: %w", i, err)
+		}
+		s.outputInfo[i] = info
 	}
 
 	return nil
@@ -177,6 +251,18 @@ func (s *Session) OutputNames() []string {
 	return s.outputNames
 }
 
+// InputInfo returns the type and shape information for all model inputs,
+// in the same order as InputNames.
+func (s *Session) InputInfo() []TensorInfo {
+	return s.inputInfo
+}
+
+// OutputInfo returns the type and shape information for all model outputs,
+// in the same order as OutputNames.
+func (s *Session) OutputInfo() []TensorInfo {
+	return s.outputInfo
+}
+
 // getInputCount retrieves the input count from ONNX Runtime (internal use)
 func (s *Session) getInputCount() (int, error) {
 	if s.ptr == 0 {
@@ -185,7 +271,7 @@ func (s *Session) getInputCount() (int, error) {
 
 	var count uintptr
 	status := s.runtime.apiFuncs.SessionGetInputCount(s.ptr, &count)
-	if err := s.runtime.statusError(status); err != nil {
+	if err := s.runtime.statusError(status, "SessionGetInputCount"); err != nil {
 		return 0, fmt.Errorf("failed to get input count: %w", err)
 	}
 
@@ -200,7 +286,7 @@ func (s *Session) getOutputCount() (int, error) {
 
 	var count uintptr
 	status := s.runtime.apiFuncs.SessionGetOutputCount(s.ptr, &count)
-	if err := s.runtime.statusError(status); err != nil {
+	if err := s.runtime.statusError(status, "SessionGetOutputCount"); err != nil {
 		return 0, fmt.Errorf("failed to get output count: %w", err)
 	}
 
@@ -219,7 +305,7 @@ func (s *Session) getInputName(index int) (string, error) {
 
 	var namePtr *byte
 	status := s.runtime.apiFuncs.SessionGetInputName(s.ptr, uintptr(index), s.runtime.allocator.ptr, &namePtr)
-	if err := s.runtime.statusError(status); err != nil {
+	if err := s.runtime.statusError(status, "SessionGetInputName"); err != nil {
 		return "", fmt.Errorf("failed to get input name: %w", err)
 	}
 
@@ -243,7 +329,7 @@ func (s *Session) getOutputName(index int) (string, error) {
 
 	var namePtr *byte
 	status := s.runtime.apiFuncs.SessionGetOutputName(s.ptr, uintptr(index), s.runtime.allocator.ptr, &namePtr)
-	if err := s.runtime.statusError(status); err != nil {
+	if err := s.runtime.statusError(status, "SessionGetOutputName"); err != nil {
 		return "", fmt.Errorf("failed to get output name: %w", err)
 	}
 
@@ -255,11 +341,76 @@ func (s *Session) getOutputName(index int) (string, error) {
 	return name, nil
 }
 
+// getInputInfo retrieves the input type info from ONNX Runtime (internal use)
+func (s *Session) getInputInfo(index int, name string) (TensorInfo, error) {
+	if s.ptr == 0 {
+		return TensorInfo{}, ErrSessionClosed
+	}
+
+	var typeInfo api.OrtTypeInfo
+	status := s.runtime.apiFuncs.SessionGetInputTypeInfo(s.ptr, uintptr(index), &typeInfo)
+	if err := s.runtime.statusError(status, "SessionGetInputTypeInfo"); err != nil {
+		return TensorInfo{}, fmt.Errorf("failed to get input type info: %w", err)
+	}
+	defer s.runtime.apiFuncs.ReleaseTypeInfo(typeInfo)
+
+	return s.runtime.newTensorInfoFromTypeInfo(name, typeInfo)
+}
+
+// getOutputInfo retrieves the output type info from ONNX Runtime (internal use)
+func (s *Session) getOutputInfo(index int, name string) (TensorInfo, error) {
+	if s.ptr == 0 {
+		return TensorInfo{}, ErrSessionClosed
+	}
+
+	var typeInfo api.OrtTypeInfo
+	status := s.runtime.apiFuncs.SessionGetOutputTypeInfo(s.ptr, uintptr(index), &typeInfo)
+	if err := s.runtime.statusError(status, "SessionGetOutputTypeInfo"); err != nil {
+		return TensorInfo{}, fmt.Errorf("failed to get output type info: %w", err)
+	}
+	defer s.runtime.apiFuncs.ReleaseTypeInfo(typeInfo)
+
+	return s.runtime.newTensorInfoFromTypeInfo(name, typeInfo)
+}
+
+// EndProfiling stops profiling and returns the path of the generated profile file.
+// Profiling must have been enabled with SessionOptions.ProfileFilePrefix.
+// The file can be parsed with ParseProfileFile.
+func (s *Session) EndProfiling() (string, error) {
+	if s.ptr == 0 {
+		return "", ErrSessionClosed
+	}
+
+	if s.runtime.allocator == nil {
+		return "", errors.New("allocator not initialized")
+	}
+
+	var pathPtr *byte
+	status := s.runtime.apiFuncs.SessionEndProfiling(s.ptr, s.runtime.allocator.ptr, &pathPtr)
+	if err := s.runtime.statusError(status, "SessionEndProfiling"); err != nil {
+		return "", fmt.Errorf("failed to end profiling: %w", err)
+	}
+
+	return s.runtime.allocator.takeString(pathPtr), nil
+}
+
 // RunOption is a functional option for configuring inference execution.
 type RunOption func(*runConfig)
 
 type runConfig struct {
 	outputNames []string
+	outputs     map[string]*Value
+
+	// run options
+	runTag            string
+	logSeverityLevel  *LoggingLevel
+	logVerbosityLevel *int
+	configEntries     map[string]string
+}
+
+// hasRunOptions reports whether the config requires a native run options handle.
+func (c *runConfig) hasRunOptions() bool {
+	return c.runTag != "" || c.logSeverityLevel != nil || c.logVerbosityLevel != nil || len(c.configEntries) > 0
 }
 
 // WithOutputNames specifies which outputs to compute during inference.
@@ -270,13 +421,113 @@ func WithOutputNames(names ...string) RunOption {
 	}
 }
 
+// WithOutputs provides preallocated values for outputs, keyed by output name.
+// ONNX Runtime writes the results into these values instead of allocating new
+// ones, and Run returns them as-is for the corresponding outputs. Each value
+// must match the type and shape of its output. Outputs without a provided
+// value are allocated by ONNX Runtime as usual.
+//
+// The caller keeps ownership of the provided values; they must not be closed
+// until Run returns.
+func WithOutputs(outputs map[string]*Value) RunOption {
+	return func(c *runConfig) {
+		c.outputs = outputs
+	}
+}
+
+// WithRunTag sets a tag for the inference run.
+// The tag is included in log messages emitted during the run.
+func WithRunTag(tag string) RunOption {
+	return func(c *runConfig) {
+		c.runTag = tag
+	}
+}
+
+// WithRunLogSeverityLevel sets the minimum log severity level for the inference run.
+func WithRunLogSeverityLevel(level LoggingLevel) RunOption {
+	return func(c *runConfig) {
+		c.logSeverityLevel = &level
+	}
+}
+
+// WithRunLogVerbosityLevel sets the VLOG verbosity level for the inference run.
+// It only takes effect when the log severity level is LoggingLevelVerbose.
+func WithRunLogVerbosityLevel(level int) RunOption {
+	return func(c *runConfig) {
+		c.logVerbosityLevel = &level
+	}
+}
+
+// WithRunConfigEntry adds a run configuration entry for the inference run.
+// For example, the key "memory.enable_memory_arena_shrinkage" with the value
+// "cpu:0" shrinks the CPU memory arena when the run completes.
+// It can be specified multiple times to add several entries.
+func WithRunConfigEntry(key, value string) RunOption {
+	return func(c *runConfig) {
+		if c.configEntries == nil {
+			c.configEntries = make(map[string]string)
+		}
+		c.configEntries[key] = value
+	}
+}
+
 // Run executes the model with the provided inputs and returns the computed outputs.
 // The inputs parameter is a map from input name to tensor value.
+// An optional input can be explicitly left empty by passing NewEmptyOptionalValue.
+//
+// The inputs are validated against the model metadata before the model runs.
+// Unknown and missing inputs are reported as *UnknownInputError and
+// *MissingInputError, and tensors that do not match the expected element type,
+// rank or fixed dimensions as *ElementTypeMismatchError, *RankMismatchError and
+// *DimensionMismatchError.
+//
+// If ctx is cancelled or its deadline expires while the model is running, the
+// inference is terminated and the returned error wraps both ctx.Err() and the
+// RuntimeError reported by ONNX Runtime.
 func (s *Session) Run(ctx context.Context, inputs map[string]*Value, opts ...RunOption) (map[string]*Value, error) {
 	if s.ptr == 0 {
 		return nil, ErrSessionClosed
 	}
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	req, err := s.newRunRequest(inputs, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	runOptionsPtr, releaseRunOptions, err := s.runtime.prepareRunOptions(ctx, req.config)
+	if err != nil {
+		return nil, err
+	}
+	defer releaseRunOptions()
+
+	// Call the low-level run method
+	outputValues, err := s.run(runOptionsPtr, req.inputNames, req.inputs, req.config.outputNames, req.outputs)
+	if err != nil {
+		if ctxErr := ctx.Err(); ctxErr != nil {
+			return nil, fmt.Errorf("%w: %w", ctxErr, err)
+		}
+		return nil, err
+	}
+
+	return req.outputMap(outputValues), nil
+}
 
+// runRequest holds the inputs and outputs of a run resolved from the
+// arguments of Run (internal use)
+type runRequest struct {
+	config     *runConfig
+	inputNames []string
+	inputs     []*Value
+	// outputs holds preallocated outputs; nil entries are allocated by ONNX Runtime
+	outputs []*Value
+}
+
+// newRunRequest applies opts and builds the input and output arrays of a run
+// from the inputs map using cached metadata (internal use)
+func (s *Session) newRunRequest(inputs map[string]*Value, opts []RunOption) (*runRequest, error) {
 	config := &runConfig{
 		outputNames: s.outputNames, // default: all outputs
 	}
@@ -284,87 +535,252 @@ func (s *Session) Run(ctx context.Context, inputs map[string]*Value, opts ...Run
 		opt(config)
 	}
 
-	// Build input arrays from map using cached metadata
-	inputNames := make([]string, 0, len(s.inputNames))
-	inputValues := make([]*Value, 0, len(s.inputNames))
+	req := &runRequest{
+		config:     config,
+		inputNames: make([]string, 0, len(s.inputNames)),
+		inputs:     make([]*Value, 0, len(s.inputNames)),
+		outputs:    make([]*Value, len(config.outputNames)),
+	}
 
-	for _, name := range s.inputNames {
-		if value, ok := inputs[name]; ok {
-			inputNames = append(inputNames, name)
-			inputValues = append(inputValues, value)
-		} else {
-			inputNames = append(inputNames, "")
-			inputValues = append(inputValues, nil)
+	for _, name := range slices.Sorted(maps.Keys(inputs)) {
+		if !slices.Contains(s.inputNames, name) {
+			return nil, &UnknownInputError{Name: name}
 		}
 	}
 
-	// Call the low-level run method
-	outputValues, err := s.run(inputNames, inputValues, config.outputNames)
+	for i, name := range s.inputNames {
+		value := inputs[name]
+		if value == nil || value.emptyOptional {
+			if s.inputInfo[i].Type == ONNXTypeOptional {
+				// Omitted and explicitly empty optional inputs are not fed
+				continue
+			}
+			return nil, &MissingInputError{Name: name}
+		}
+		if err := validateInput(s.inputInfo[i], value); err != nil {
+			return nil, err
+		}
+		req.inputNames = append(req.inputNames, name)
+		req.inputs = append(req.inputs, value)
+	}
+
+	// Match preallocated outputs to the requested output names
+	if len(config.outputs) > 0 {
+		for i, name := range config.outputNames {
+			req.outputs[i] = config.outputs[name]
+		}
+		for name, value := range config.outputs {
+			if !slices.Contains(config.outputNames, name) {
+				return nil, fmt.Errorf("preallocated output %q is not a requested output", name)
+			}
+			if value == nil || value.ptr == 0 {
+				return nil, fmt.Errorf("preallocated output %q: %w", name, ErrValueClosed)
+			}
+		}
+	}
+
+	return req, nil
+}
+
+// inputInfoByName returns the metadata of the input with the given name.
+func (s *Session) inputInfoByName(name string) (TensorInfo, bool) {
+	i := slices.Index(s.inputNames, name)
+	if i < 0 {
+		return TensorInfo{}, false
+	}
+	return s.inputInfo[i], true
+}
+
+// validateInput checks a tensor input against the type and shape expected by the model.
+// Non-tensor inputs are left to ONNX Runtime to validate.
+func validateInput(info TensorInfo, value *Value) error {
+	if err := value.checkOpen(); err != nil {
+		return fmt.Errorf("input %q: %w", info.Name, err)
+	}
+	if info.Type != ONNXTypeTensor {
+		return nil
+	}
+	valueType, err := value.GetValueType()
 	if err != nil {
-		return nil, err
+		return fmt.Errorf("input %q: %w", info.Name, err)
+	}
+	if valueType != ONNXTypeTensor {
+		return nil
+	}
+
+	elementType, err := value.GetTensorElementType()
+	if err != nil {
+		return fmt.Errorf("input %q: %w", info.Name, err)
 	}
+	if elementType != info.ElementType {
+		return &ElementTypeMismatchError{Name: info.Name, Expected: info.ElementType, Actual: elementType}
+	}
+
+	shape, err := value.GetTensorShape()
+	if err != nil {
+		return fmt.Errorf("input %q: %w", info.Name, err)
+	}
+	if len(shape) != len(info.Shape) {
+		return &RankMismatchError{Name: info.Name, Expected: len(info.Shape), Actual: len(shape)}
+	}
+	for axis, dim := range info.Shape {
+		// Dynamic dimensions are reported as -1
+		if dim >= 0 && shape[axis] != dim {
+			return &DimensionMismatchError{Name: info.Name, Axis: axis, Expected: dim, Actual: shape[axis]}
+		}
+	}
+
+	return nil
+}
 
-	// Convert output arrays to map
-	outputs := make(map[string]*Value, len(outputValues))
-	for i, value := range outputValues {
-		outputs[config.outputNames[i]] = value
+// outputMap converts the computed output values to a map keyed by output name.
+func (req *runRequest) outputMap(values []*Value) map[string]*Value {
+	outputs := make(map[string]*Value, len(values))
+	for i, value := range values {
+		outputs[req.config.outputNames[i]] = value
 	}
-	return outputs, nil
+	return outputs
 }
 
 // run executes the model with the provided inputs and returns the computed outputs.
-func (s *Session) run(inputNames []string, inputs []*Value, outputNames []string) ([]*Value, error) {
+// Non-nil entries of outputs are preallocated values that receive the results;
+// nil entries are allocated by ONNX Runtime.
+func (s *Session) run(runOptions api.OrtRunOptions, inputNames []string, inputs []*Value, outputNames []string, outputs []*Value) ([]*Value, error) {
+	args, err := newRunArgs(inputNames, inputs, outputNames, outputs)
+	if err != nil {
+		return nil, err
+	}
+
+	// Call Run
+	status := s.runtime.apiFuncs.Run(
+		s.ptr,
+		runOptions,
+		firstElement(args.inputNames),
+		firstElement(args.inputs),
+		uintptr(len(args.inputs)),
+		firstElement(args.outputNames),
+		uintptr(len(args.outputNames)),
+		firstElement(args.outputs),
+	)
+	if err := s.runtime.statusError(status, "Run"); err != nil {
+		return nil, fmt.Errorf("failed to run inference: %w", err)
+	}
+
+	return s.wrapOutputs(args.outputs, outputs), nil
+}
+
+// firstElement returns a pointer to the first element of s, or nil if s is empty.
+// Omitted optional inputs can leave the argument arrays of a run empty.
+func firstElement[T any](s []T) *T {
+	if len(s) == 0 {
+		return nil
+	}
+	return &s[0]
+}
+
+// runArgs holds the native arguments of a run (internal use)
+type runArgs struct {
+	inputNames  []*byte
+	inputs      []api.OrtValue
+	outputNames []*byte
+	outputs     []api.OrtValue
+}
+
+// newRunArgs converts the inputs and outputs of a run to native arguments (internal use)
+func newRunArgs(inputNames []string, inputs []*Value, outputNames []string, outputs []*Value) (*runArgs, error) {
 	if len(inputNames) != len(inputs) {
 		return nil, fmt.Errorf("number of input names (%d) must match number of inputs (%d)", len(inputNames), len(inputs))
 	}
+	if len(outputNames) != len(outputs) {
+		return nil, fmt.Errorf("number of output names (%d) must match number of outputs (%d)", len(outputNames), len(outputs))
+	}
+
+	args := &runArgs{
+		inputNames:  make([]*byte, len(inputNames)),
+		inputs:      make([]api.OrtValue, len(inputs)),
+		outputNames: make([]*byte, len(outputNames)),
+		outputs:     make([]api.OrtValue, len(outputs)),
+	}
 
 	// Prepare input name pointers
-	inputNamePtrs := make([]*byte, len(inputNames))
 	for i, name := range inputNames {
 		nameBytes := append([]byte(name), 0)
-		inputNamePtrs[i] = &nameBytes[0]
+		args.inputNames[i] = &nameBytes[0]
 	}
 
 	// Prepare input value pointers
-	inputValuePtrs := make([]api.OrtValue, len(inputs))
 	for i, input := range inputs {
 		if input != nil {
-			inputValuePtrs[i] = input.ptr
+			args.inputs[i] = input.ptr
 		}
 	}
 
 	// Prepare output name pointers
-	outputNamePtrs := make([]*byte, len(outputNames))
 	for i, name := range outputNames {
 		nameBytes := append([]byte(name), 0)
-		outputNamePtrs[i] = &nameBytes[0]
+		args.outputNames[i] = &nameBytes[0]
 	}
 
 	// Prepare output value pointers
-	outputValuePtrs := make([]api.OrtValue, len(outputNames))
-
-	// Call Run
-	status := s.runtime.apiFuncs.Run(
-		s.ptr,
-		0, // run options (NULL)
-		&inputNamePtrs[0],
-		&inputValuePtrs[0],
-		uintptr(len(inputs)),
-		&outputNamePtrs[0],
-		uintptr(len(outputNames)),
-		&outputValuePtrs[0],
-	)
-	if err := s.runtime.statusError(status); err != nil {
-		return nil, fmt.Errorf("failed to run inference: %w", err)
+	for i, output := range outputs {
+		if output != nil {
+			args.outputs[i] = output.ptr
+		}
 	}
 
-	// Wrap output values
-	outputs := make([]*Value, len(outputValuePtrs))
-	for i, ptr := range outputValuePtrs {
-		outputs[i] = s.runtime.newValueFromPtr(ptr)
+	return args, nil
+}
+
+// wrapOutputs wraps the output value pointers filled in by a run. Preallocated
+// outputs are returned as-is (internal use)
+func (s *Session) wrapOutputs(ptrs []api.OrtValue, outputs []*Value) []*Value {
+	results := make([]*Value, len(ptrs))
+	for i, ptr := range ptrs {
+		if outputs[i] != nil {
+			results[i] = outputs[i]
+			continue
+		}
+		results[i] = s.runtime.newValueFromPtr(ptr)
 	}
+	return results
+}
 
-	return outputs, nil
+// configureSessionOptions applies all session options to the native session options.
+func (r *Runtime) configureSessionOptions(optsPtr api.OrtSessionOptions, options *SessionOptions) error {
+	if err := r.configureIntraOpNumThreads(optsPtr, options); err != nil {
+		return fmt.Errorf("failed to configure intra-op num threads: %w", err)
+	}
+	if err := r.configureInterOpNumThreads(optsPtr, options); err != nil {
+		return fmt.Errorf("failed to configure inter-op num threads: %w", err)
+	}
+	if err := r.configureGraphOptimizationLevel(optsPtr, options); err != nil {
+		return fmt.Errorf("failed to configure graph optimization level: %w", err)
+	}
+	if err := r.configureExecutionMode(optsPtr, options); err != nil {
+		return fmt.Errorf("failed to configure execution mode: %w", err)
+	}
+	if err := r.configureMemoryPattern(optsPtr, options); err != nil {
+		return fmt.Errorf("failed to configure memory pattern: %w", err)
+	}
+	if err := r.configureCPUMemoryArena(optsPtr, options); err != nil {
+		return fmt.Errorf("failed to configure CPU memory arena: %w", err)
+	}
+	if err := r.configureDeterministicCompute(optsPtr, options); err != nil {
+		return fmt.Errorf("failed to configure deterministic compute: %w", err)
+	}
+	if err := r.configureOptimizedModelFilePath(optsPtr, options); err != nil {
+		return fmt.Errorf("failed to configure optimized model file path: %w", err)
+	}
+	if err := r.configureProfiling(optsPtr, options); err != nil {
+		return fmt.Errorf("failed to configure profiling: %w", err)
+	}
+	if err := r.configureConfigEntries(optsPtr, options); err != nil {
+		return fmt.Errorf("failed to configure session config entries: %w", err)
+	}
+	if err := r.configureExecutionProviders(optsPtr, options); err != nil {
+		return fmt.Errorf("failed to configure execution providers: %w", err)
+	}
+	return nil
 }
 
 func (r *Runtime) configureIntraOpNumThreads(optsPtr api.OrtSessionOptions, options *SessionOptions) error {
@@ -372,12 +788,120 @@ func (r *Runtime) configureIntraOpNumThreads(optsPtr api.OrtSessionOptions, opti
 		return nil
 	}
 	status := r.apiFuncs.SetIntraOpNumThreads(optsPtr, int32(options.IntraOpNumThreads))
-	if err := r.statusError(status); err != nil {
+	if err := r.statusError(status, "SetIntraOpNumThreads"); err != nil {
 		return fmt.Errorf("failed to set intra-op num threads: %w", err)
 	}
 	return nil
 }
 
+func (r *Runtime) configureInterOpNumThreads(optsPtr api.OrtSessionOptions, options *SessionOptions) error {
+	if options.InterOpNumThreads <= 0 {
+		return nil
+	}
+	status := r.apiFuncs.SetInterOpNumThreads(optsPtr, int32(options.InterOpNumThreads))
+	if err := r.statusError(status, "SetInterOpNumThreads"); err != nil {
+		return fmt.Errorf("failed to set inter-op num threads: %w", err)
+	}
+	return nil
+}
+
+func (r *Runtime) configureGraphOptimizationLevel(optsPtr api.OrtSessionOptions, options *SessionOptions) error {
+	if options.GraphOptimizationLevel == GraphOptimizationLevelDefault {
+		return nil
+	}
+	level, err := options.GraphOptimizationLevel.toAPI()
+	if err != nil {
+		return err
+	}
+	status := r.apiFuncs.SetSessionGraphOptimizationLevel(optsPtr, level)
+	if err := r.statusError(status, "SetSessionGraphOptimizationLevel"); err != nil {
+		return fmt.Errorf("failed to set graph optimization level: %w", err)
+	}
+	return nil
+}
+
+func (r *Runtime) configureExecutionMode(optsPtr api.OrtSessionOptions, options *SessionOptions) error {
+	if options.ExecutionMode == ExecutionModeSequential {
+		return nil
+	}
+	status := r.apiFuncs.SetSessionExecutionMode(optsPtr, options.ExecutionMode)
+	if err := r.statusError(status, "SetSessionExecutionMode"); err != nil {
+		return fmt.Errorf("failed to set execution mode: %w", err)
+	}
+	return nil
+}
+
+func (r *Runtime) configureMemoryPattern(optsPtr api.OrtSessionOptions, options *SessionOptions) error {
+	if !options.DisableMemoryPattern {
+		return nil
+	}
+	status := r.apiFuncs.DisableMemPattern(optsPtr)
+	if err := r.statusError(status, "DisableMemPattern"); err != nil {
+		return fmt.Errorf("failed to disable memory pattern: %w", err)
+	}
+	return nil
+}
+
+func (r *Runtime) configureCPUMemoryArena(optsPtr api.OrtSessionOptions, options *SessionOptions) error {
+	if !options.DisableCPUMemoryArena {
+		return nil
+	}
+	status := r.apiFuncs.DisableCpuMemArena(optsPtr)
+	if err := r.statusError(status, "DisableCpuMemArena"); err != nil {
+		return fmt.Errorf("failed to disable CPU memory arena: %w", err)
+	}
+	return nil
+}
+
+func (r *Runtime) configureDeterministicCompute(optsPtr api.OrtSessionOptions, options *SessionOptions) error {
+	if !options.DeterministicCompute {
+		return nil
+	}
+	status := r.apiFuncs.SetDeterministicCompute(optsPtr, true)
+	if err := r.statusError(status, "SetDeterministicCompute"); err != nil {
+		return fmt.Errorf("failed to set deterministic compute: %w", err)
+	}
+	return nil
+}
+
+func (r *Runtime) configureOptimizedModelFilePath(optsPtr api.OrtSessionOptions, options *SessionOptions) error {
+	if options.OptimizedModelFilePath == "" {
+		return nil
+	}
+	pathBytes := append([]byte(options.OptimizedModelFilePath), 0)
+	status := r.apiFuncs.SetOptimizedModelFilePath(optsPtr, &pathBytes[0])
+	if err := r.statusError(status, "SetOptimizedModelFilePath"); err != nil {
+		return fmt.Errorf("failed to set optimized model file path: %w", err)
+	}
+	return nil
+}
+
+func (r *Runtime) configureProfiling(optsPtr api.OrtSessionOptions, options *SessionOptions) error {
+	if options.ProfileFilePrefix == "" {
+		return nil
+	}
+	prefixBytes := append([]byte(options.ProfileFilePrefix), 0)
+	status := r.apiFuncs.EnableProfiling(optsPtr, &prefixBytes[0])
+	if err := r.statusError(status, "EnableProfiling"); err != nil {
+		return fmt.Errorf("failed to enable profiling: %w", err)
+	}
+	return nil
+}
+
+// configureConfigEntries adds the session config entries to the session options.
+// Entries are applied in key order so that failures are reported deterministically.
+func (r *Runtime) configureConfigEntries(optsPtr api.OrtSessionOptions, options *SessionOptions) error {
+	for _, key := range slices.Sorted(maps.Keys(options.ConfigEntries)) {
+		keyBytes := append([]byte(key), 0)
+		valueBytes := append([]byte(options.ConfigEntries[key]), 0)
+		status := r.apiFuncs.AddSessionConfigEntry(optsPtr, &keyBytes[0], &valueBytes[0])
+		if err := r.statusError(status, "AddSessionConfigEntry"); err != nil {
+			return fmt.Errorf("failed to add session config entry %q: %w", key, err)
+		}
+	}
+	return nil
+}
+
 // configureExecutionProviders configures execution providers for the session options.
 func (r *Runtime) configureExecutionProviders(optsPtr api.OrtSessionOptions, options *SessionOptions) error {
 	if len(options.ExecutionProviders) == 0 {
@@ -385,18 +909,34 @@ func (r *Runtime) configureExecutionProviders(optsPtr api.OrtSessionOptions, opt
 	}
 
 	for _, provider := range options.ExecutionProviders {
-		providerNameBytes := append([]byte(provider), 0)
+		providerNameBytes := append([]byte(provider.Name), 0)
+
+		// Marshal options into C key/value arrays
+		keys := slices.Sorted(maps.Keys(provider.Options))
+		keyPtrs := make([]*byte, len(keys))
+		valuePtrs := make([]*byte, len(keys))
+		for i, key := range keys {
+			keyBytes := append([]byte(key), 0)
+			valueBytes := append([]byte(provider.Options[key]), 0)
+			keyPtrs[i] = &keyBytes[0]
+			valuePtrs[i] = &valueBytes[0]
+		}
+
+		var keysPtr, valuesPtr **byte
+		if len(keys) > 0 {
+			keysPtr = &keyPtrs[0]
+			valuesPtr = &valuePtrs[0]
+		}
 
-		// Call with no options (empty keys/values)
 		status := r.apiFuncs.SessionOptionsAppendExecutionProvider(
 			optsPtr,
 			&providerNameBytes[0],
-			nil, // no option keys
-			nil, // no option values
-			0,   // no options
+			keysPtr,
+			valuesPtr,
+			uintptr(len(keys)),
 		)
-		if err := r.statusError(status); err != nil {
-			return fmt.Errorf("failed to append execution provider %q: %w", provider, err)
+		if err := r.statusError(status, "SessionOptionsAppendExecutionProvider"); err != nil {
+			return fmt.Errorf("failed to append execution provider %q: %w", provider.Name, err)
 		}
 	}
 
@@ -2,10 +2,16 @@ package onnxruntime
 
 import (
 	"bytes"
+	"context"
 	"errors"
 	"os"
+	"path/filepath"
 	"slices"
+	"strings"
 	"testing"
+	"time"
+
+	"github.com/shota3506/onnxruntime-purego/onnxruntime/internal/api"
 )
 
 func TestNewSessionFromReader(t *testing.T) {
@@ -59,6 +65,214 @@ func TestNewSessionWithOptions(t *testing.T) {
 	defer session.Close()
 }
 
+func TestNewSessionWithTuningOptions(t *testing.T) {
+	runtime := newTestRuntime(t)
+
+	env, err := runtime.NewEnv("test", LoggingLevelWarning)
+	if err != nil {
+		t.Fatalf("Failed to create environment: %v", err)
+	}
+	defer env.Close()
+
+	levels := []GraphOptimizationLevel{
+		GraphOptimizationLevelDisabled,
+		GraphOptimizationLevelBasic,
+		GraphOptimizationLevelExtended,
+		GraphOptimizationLevelAll,
+	}
+
+	for _, level := range levels {
+		opts := &SessionOptions{
+			IntraOpNumThreads:      1,
+			InterOpNumThreads:      2,
+			GraphOptimizationLevel: level,
+			ExecutionMode:          ExecutionModeParallel,
+			DisableMemoryPattern:   true,
+			DisableCPUMemoryArena:  true,
+			DeterministicCompute:   true,
+		}
+
+		session, err := runtime.NewSession(env, testModelPath(), opts)
+		if err != nil {
+			t.Fatalf("Failed to create session with graph optimization level %d: %v", level, err)
+		}
+		session.Close()
+	}
+}
+
+func TestNewSessionWithInvalidGraphOptimizationLevel(t *testing.T) {
+	runtime := newTestRuntime(t)
+
+	env, err := runtime.NewEnv("test", LoggingLevelWarning)
+	if err != nil {
+		t.Fatalf("Failed to create environment: %v", err)
+	}
+	defer env.Close()
+
+	opts := &SessionOptions{
+		GraphOptimizationLevel: GraphOptimizationLevel(100),
+	}
+
+	_, err = runtime.NewSession(env, testModelPath(), opts)
+	if err == nil {
+		t.Fatal("Expected error for invalid graph optimization level")
+	}
+}
+
+func TestGraphOptimizationLevelToAPI(t *testing.T) {
+	testCases := []struct {
+		level    GraphOptimizationLevel
+		expected api.GraphOptimizationLevel
+	}{
+		{GraphOptimizationLevelDefault, 99},
+		{GraphOptimizationLevelDisabled, 0},
+		{GraphOptimizationLevelBasic, 1},
+		{GraphOptimizationLevelExtended, 2},
+		{GraphOptimizationLevelAll, 99},
+	}
+
+	for _, tc := range testCases {
+		got, err := tc.level.toAPI()
+		if err != nil {
+			t.Errorf("toAPI(%d) returned error: %v", tc.level, err)
+			continue
+		}
+		if got != tc.expected {
+			t.Errorf("toAPI(%d) = %d, expected %d", tc.level, got, tc.expected)
+		}
+	}
+
+	if _, err := GraphOptimizationLevel(99).toAPI(); err == nil {
+		t.Error("Expected error for a raw C API level")
+	}
+}
+
+func TestNewSessionWithConfigEntries(t *testing.T) {
+	runtime := newTestRuntime(t)
+
+	env, err := runtime.NewEnv("test", LoggingLevelWarning)
+	if err != nil {
+		t.Fatalf("Failed to create environment: %v", err)
+	}
+	defer env.Close()
+
+	t.Run("Valid", func(t *testing.T) {
+		opts := &SessionOptions{
+			ConfigEntries: map[string]string{
+				"session.intra_op.allow_spinning": "0",
+				"session.use_env_allocators":      "1",
+			},
+		}
+
+		session, err := runtime.NewSession(env, testModelPath(), opts)
+		if err != nil {
+			t.Fatalf("Failed to create session: %v", err)
+		}
+		session.Close()
+	})
+
+	t.Run("InvalidKey", func(t *testing.T) {
+		invalidKey := strings.Repeat("k", 256)
+		opts := &SessionOptions{
+			ConfigEntries: map[string]string{
+				invalidKey: "1",
+			},
+		}
+
+		_, err := runtime.NewSession(env, testModelPath(), opts)
+		var ortErr *RuntimeError
+		if !errors.As(err, &ortErr) {
+			t.Fatalf("Expected RuntimeError, got: %v", err)
+		}
+		if !strings.Contains(err.Error(), invalidKey) {
+			t.Errorf("Error should name the invalid key, got: %v", err)
+		}
+	})
+}
+
+func TestNewSessionWithOptimizedModelFilePath(t *testing.T) {
+	runtime := newTestRuntime(t)
+
+	env, err := runtime.NewEnv("test", LoggingLevelWarning)
+	if err != nil {
+		t.Fatalf("Failed to create environment: %v", err)
+	}
+	defer env.Close()
+
+	optimizedModelPath := filepath.Join(t.TempDir(), "model.optimized.onnx")
+	opts := &SessionOptions{
+		GraphOptimizationLevel: GraphOptimizationLevelExtended,
+		OptimizedModelFilePath: optimizedModelPath,
+	}
+
+	session, err := runtime.NewSession(env, testModelPath(), opts)
+	if err != nil {
+		t.Fatalf("Failed to create session: %v", err)
+	}
+	session.Close()
+
+	if _, err := os.Stat(optimizedModelPath); err != nil {
+		t.Fatalf("Optimized model was not saved: %v", err)
+	}
+}
+
+func TestOptimizeModel(t *testing.T) {
+	runtime := newTestRuntime(t)
+
+	env, err := runtime.NewEnv("test", LoggingLevelWarning)
+	if err != nil {
+		t.Fatalf("Failed to create environment: %v", err)
+	}
+	defer env.Close()
+
+	for _, name := range []string{"model.onnx", "model.ort"} {
+		t.Run(name, func(t *testing.T) {
+			outputPath := filepath.Join(t.TempDir(), name)
+			if err := runtime.OptimizeModel(env, testModelPath(), outputPath, GraphOptimizationLevelAll); err != nil {
+				t.Fatalf("Failed to optimize model: %v", err)
+			}
+
+			session, err := runtime.NewSession(env, outputPath, nil)
+			if err != nil {
+				t.Fatalf("Failed to create session from optimized model: %v", err)
+			}
+			defer session.Close()
+
+			if !slices.Equal(session.InputNames(), []string{"input"}) {
+				t.Errorf("Expected input names ['input'], got %v", session.InputNames())
+			}
+		})
+	}
+}
+
+func TestNewSessionWithUnknownExecutionProvider(t *testing.T) {
+	runtime := newTestRuntime(t)
+
+	env, err := runtime.NewEnv("test", LoggingLevelWarning)
+	if err != nil {
+		t.Fatalf("Failed to create environment: %v", err)
+	}
+	defer env.Close()
+
+	opts := &SessionOptions{
+		ExecutionProviders: []ExecutionProvider{
+			{
+				Name:    "NoSuchProvider",
+				Options: map[string]string{"device_id": "0"},
+			},
+		},
+	}
+
+	_, err = runtime.NewSession(env, testModelPath(), opts)
+	var ortErr *RuntimeError
+	if !errors.As(err, &ortErr) {
+		t.Fatalf("Expected RuntimeError, got: %v", err)
+	}
+	if !strings.Contains(err.Error(), "NoSuchProvider") {
+		t.Errorf("Error should name the provider, got: %v", err)
+	}
+}
+
 func TestNewSessionFromReaderWithInvalidModel(t *testing.T) {
 	runtime := newTestRuntime(t)
 
@@ -93,6 +307,55 @@ func TestSessionOutputNames(t *testing.T) {
 	}
 }
 
+func TestSessionInputInfo(t *testing.T) {
+	session := newTestSession(t, newTestRuntime(t))
+
+	inputInfo := session.InputInfo()
+	if len(inputInfo) != 1 {
+		t.Fatalf("Expected 1 input info, got %d", len(inputInfo))
+	}
+
+	info := inputInfo[0]
+	if info.Name != "input" {
+		t.Errorf("Expected input name 'input', got %q", info.Name)
+	}
+	if info.Type != ONNXTypeTensor {
+		t.Errorf("Expected tensor type, got %d", info.Type)
+	}
+	if info.ElementType != ONNXTensorElementDataTypeFloat {
+		t.Errorf("Expected float element type, got %d", info.ElementType)
+	}
+	if !slices.Equal(info.Shape, []int64{-1, 10}) {
+		t.Errorf("Expected shape [-1 10], got %v", info.Shape)
+	}
+	if !slices.Equal(info.SymbolicShape, []string{"batch_size", ""}) {
+		t.Errorf("Expected symbolic shape [batch_size ''], got %q", info.SymbolicShape)
+	}
+}
+
+func TestSessionOutputInfo(t *testing.T) {
+	session := newTestSession(t, newTestRuntime(t))
+
+	outputInfo := session.OutputInfo()
+	if len(outputInfo) != 1 {
+		t.Fatalf("Expected 1 output info, got %d", len(outputInfo))
+	}
+
+	info := outputInfo[0]
+	if info.Name != "logits" {
+		t.Errorf("Expected output name 'logits', got %q", info.Name)
+	}
+	if info.ElementType != ONNXTensorElementDataTypeFloat {
+		t.Errorf("Expected float element type, got %d", info.ElementType)
+	}
+	if !slices.Equal(info.Shape, []int64{-1, 3}) {
+		t.Errorf("Expected shape [-1 3], got %v", info.Shape)
+	}
+	if info.SymbolicShape[0] != "batch_size" {
+		t.Errorf("Expected first symbolic dimension 'batch_size', got %q", info.SymbolicShape[0])
+	}
+}
+
 func TestSessionRun(t *testing.T) {
 	runtime := newTestRuntime(t)
 	session := newTestSession(t, runtime)
@@ -185,6 +448,43 @@ func TestSessionRunBatch(t *testing.T) {
 	}
 }
 
+func TestSessionRunComplex(t *testing.T) {
+	runtime := newTestRuntime(t)
+	session := newTestSessionFromFile(t, runtime, testDataPath("complex.onnx"), nil)
+
+	data64 := []complex64{1 + 2i, -0.5 - 1.5i, 3}
+	data128 := []complex128{1 + 2i, -0.5 - 1.5i, 3}
+	shape := []int64{3}
+
+	input64, err := NewTensorValue(runtime, data64, shape)
+	if err != nil {
+		t.Fatalf("Failed to create complex64 tensor: %v", err)
+	}
+	defer input64.Close()
+
+	input128, err := NewTensorValue(runtime, data128, shape)
+	if err != nil {
+		t.Fatalf("Failed to create complex128 tensor: %v", err)
+	}
+	defer input128.Close()
+
+	outputs, err := session.Run(t.Context(), map[string]*Value{
+		"x64":  input64,
+		"x128": input128,
+	})
+	if err != nil {
+		t.Fatalf("Failed to run inference: %v", err)
+	}
+	defer func() {
+		for _, output := range outputs {
+			output.Close()
+		}
+	}()
+
+	assertTensorData(t, outputs["y64"], data64, shape)
+	assertTensorData(t, outputs["y128"], data128, shape)
+}
+
 func TestSessionRunWithClosedSession(t *testing.T) {
 	runtime := newTestRuntime(t)
 	session := newTestSession(t, runtime)
@@ -223,12 +523,73 @@ func TestSessionRunWithWrongInputShape(t *testing.T) {
 		"input": tensor,
 	})
 
-	var ortErr *RuntimeError
-	if !errors.As(err, &ortErr) {
-		t.Errorf("Expected OrtError, got: %v", err)
+	var dimErr *DimensionMismatchError
+	if !errors.As(err, &dimErr) {
+		t.Fatalf("Expected DimensionMismatchError, got: %v", err)
+	}
+	if dimErr.Name != "input" || dimErr.Axis != 1 || dimErr.Expected != 10 || dimErr.Actual != 5 {
+		t.Errorf("Unexpected dimension mismatch: %+v", dimErr)
+	}
+}
+
+func TestSessionRunWithWrongInputRank(t *testing.T) {
+	runtime := newTestRuntime(t)
+	session := newTestSession(t, runtime)
+
+	tensor, err := NewTensorValue(runtime, make([]float32, 10), []int64{10})
+	if err != nil {
+		t.Fatalf("Failed to create tensor: %v", err)
 	}
-	if ortErr.Code != ErrorCodeInvalidArgument {
-		t.Errorf("Expected OrtInvalidArgument error code, got: %d", ortErr.Code)
+	defer tensor.Close()
+
+	_, err = session.Run(t.Context(), map[string]*Value{
+		"input": tensor,
+	})
+
+	var rankErr *RankMismatchError
+	if !errors.As(err, &rankErr) {
+		t.Fatalf("Expected RankMismatchError, got: %v", err)
+	}
+	if rankErr.Name != "input" || rankErr.Expected != 2 || rankErr.Actual != 1 {
+		t.Errorf("Unexpected rank mismatch: %+v", rankErr)
+	}
+}
+
+func TestSessionRunWithWrongInputElementType(t *testing.T) {
+	runtime := newTestRuntime(t)
+	session := newTestSession(t, runtime)
+
+	tensor, err := NewTensorValue(runtime, make([]int64, 10), []int64{1, 10})
+	if err != nil {
+		t.Fatalf("Failed to create tensor: %v", err)
+	}
+	defer tensor.Close()
+
+	_, err = session.Run(t.Context(), map[string]*Value{
+		"input": tensor,
+	})
+
+	var typeErr *ElementTypeMismatchError
+	if !errors.As(err, &typeErr) {
+		t.Fatalf("Expected ElementTypeMismatchError, got: %v", err)
+	}
+	if typeErr.Name != "input" || typeErr.Expected != ONNXTensorElementDataTypeFloat || typeErr.Actual != ONNXTensorElementDataTypeInt64 {
+		t.Errorf("Unexpected element type mismatch: %+v", typeErr)
+	}
+}
+
+func TestSessionRunWithMissingInput(t *testing.T) {
+	runtime := newTestRuntime(t)
+	session := newTestSession(t, runtime)
+
+	_, err := session.Run(t.Context(), map[string]*Value{})
+
+	var missingErr *MissingInputError
+	if !errors.As(err, &missingErr) {
+		t.Fatalf("Expected MissingInputError, got: %v", err)
+	}
+	if missingErr.Name != "input" {
+		t.Errorf("Expected missing input %q, got %q", "input", missingErr.Name)
 	}
 }
 
@@ -249,11 +610,276 @@ func TestSessionRunWithWrongInputCount(t *testing.T) {
 		"input1": tensor,
 		"input2": tensor,
 	})
-	var ortErr *RuntimeError
-	if !errors.As(err, &ortErr) {
-		t.Errorf("Expected OrtError, got: %v", err)
+	var unknownErr *UnknownInputError
+	if !errors.As(err, &unknownErr) {
+		t.Fatalf("Expected UnknownInputError, got: %v", err)
+	}
+	if unknownErr.Name != "input1" {
+		t.Errorf("Expected unknown input %q, got %q", "input1", unknownErr.Name)
+	}
+}
+
+func TestSessionRunWithCanceledContext(t *testing.T) {
+	runtime := newTestRuntime(t)
+	session := newTestSession(t, runtime)
+
+	inputData := []float32{1.0, 2.0, 3.0, 4.0, 5.0, 6.0, 7.0, 8.0, 9.0, 10.0}
+	inputTensor, err := NewTensorValue(runtime, inputData, []int64{1, 10})
+	if err != nil {
+		t.Fatalf("Failed to create input tensor: %v", err)
+	}
+	defer inputTensor.Close()
+
+	ctx, cancel := context.WithCancel(t.Context())
+	cancel()
+
+	_, err = session.Run(ctx, map[string]*Value{
+		"input": inputTensor,
+	})
+	if !errors.Is(err, context.Canceled) {
+		t.Errorf("Expected context.Canceled, got: %v", err)
+	}
+}
+
+func TestSessionRunCanceledInFlight(t *testing.T) {
+	runtime := newTestRuntime(t)
+	// loop.onnx runs a Loop that practically never terminates on its own
+	session := newTestSessionFromFile(t, runtime, testDataPath("loop.onnx"), nil)
+
+	inputTensor, err := NewTensorValue(runtime, []float32{1.0}, []int64{1})
+	if err != nil {
+		t.Fatalf("Failed to create input tensor: %v", err)
+	}
+	defer inputTensor.Close()
+
+	ctx, cancel := context.WithCancel(t.Context())
+	defer cancel()
+	time.AfterFunc(50*time.Millisecond, cancel)
+
+	_, err = session.Run(ctx, map[string]*Value{
+		"x": inputTensor,
+	})
+	if !errors.Is(err, context.Canceled) {
+		t.Errorf("Expected context.Canceled, got: %v", err)
+	}
+	var runtimeErr *RuntimeError
+	if !errors.As(err, &runtimeErr) {
+		t.Errorf("Expected RuntimeError from the terminated run, got: %v", err)
+	}
+}
+
+func TestSessionRunWithRunOptions(t *testing.T) {
+	runtime := newTestRuntime(t)
+	session := newTestSession(t, runtime)
+
+	inputData := []float32{1.0, 2.0, 3.0, 4.0, 5.0, 6.0, 7.0, 8.0, 9.0, 10.0}
+	inputTensor, err := NewTensorValue(runtime, inputData, []int64{1, 10})
+	if err != nil {
+		t.Fatalf("Failed to create input tensor: %v", err)
+	}
+	defer inputTensor.Close()
+
+	outputs, err := session.Run(context.Background(), map[string]*Value{
+		"input": inputTensor,
+	},
+		WithRunTag("test-run"),
+		WithRunLogSeverityLevel(LoggingLevelError),
+		WithRunLogVerbosityLevel(0),
+		WithRunConfigEntry("memory.enable_memory_arena_shrinkage", "cpu:0"),
+	)
+	if err != nil {
+		t.Fatalf("Failed to run inference: %v", err)
+	}
+	defer func() {
+		for _, output := range outputs {
+			output.Close()
+		}
+	}()
+
+	if len(outputs) != 1 {
+		t.Fatalf("Expected 1 output, got %d", len(outputs))
 	}
-	if ortErr.Code != ErrorCodeInvalidArgument {
-		t.Errorf("Expected OrtInvalidArgument error code, got: %d", ortErr.Code)
+}
+
+func TestSessionRunWithOutputs(t *testing.T) {
+	runtime := newTestRuntime(t)
+	session := newTestSession(t, runtime)
+
+	inputData := []float32{1.0, 2.0, 3.0, 4.0, 5.0, 6.0, 7.0, 8.0, 9.0, 10.0}
+	inputTensor, err := NewTensorValue(runtime, inputData, []int64{1, 10})
+	if err != nil {
+		t.Fatalf("Failed to create input tensor: %v", err)
+	}
+	defer inputTensor.Close()
+
+	outputs, err := session.Run(t.Context(), map[string]*Value{
+		"input": inputTensor,
+	})
+	if err != nil {
+		t.Fatalf("Failed to run inference: %v", err)
+	}
+	expected, _, err := GetTensorData[float32](outputs["logits"])
+	if err != nil {
+		t.Fatalf("Failed to get output data: %v", err)
+	}
+	outputs["logits"].Close()
+
+	t.Run("Preallocated", func(t *testing.T) {
+		outputTensor, err := NewEmptyTensor[float32](runtime, []int64{1, 3})
+		if err != nil {
+			t.Fatalf("Failed to create output tensor: %v", err)
+		}
+		defer outputTensor.Close()
+
+		for range 3 {
+			outputs, err := session.Run(t.Context(), map[string]*Value{
+				"input": inputTensor,
+			}, WithOutputs(map[string]*Value{"logits": outputTensor}))
+			if err != nil {
+				t.Fatalf("Failed to run inference: %v", err)
+			}
+			if outputs["logits"] != outputTensor {
+				t.Fatalf("Expected the preallocated output to be returned")
+			}
+			assertTensorData(t, outputTensor, expected, []int64{1, 3})
+		}
+	})
+
+	t.Run("UnknownOutput", func(t *testing.T) {
+		outputTensor, err := NewEmptyTensor[float32](runtime, []int64{1, 3})
+		if err != nil {
+			t.Fatalf("Failed to create output tensor: %v", err)
+		}
+		defer outputTensor.Close()
+
+		_, err = session.Run(t.Context(), map[string]*Value{
+			"input": inputTensor,
+		}, WithOutputs(map[string]*Value{"unknown": outputTensor}))
+		if err == nil {
+			t.Fatal("Expected error for unknown output name")
+		}
+	})
+
+	t.Run("ClosedOutput", func(t *testing.T) {
+		outputTensor, err := NewEmptyTensor[float32](runtime, []int64{1, 3})
+		if err != nil {
+			t.Fatalf("Failed to create output tensor: %v", err)
+		}
+		outputTensor.Close()
+
+		_, err = session.Run(t.Context(), map[string]*Value{
+			"input": inputTensor,
+		}, WithOutputs(map[string]*Value{"logits": outputTensor}))
+		if !errors.Is(err, ErrValueClosed) {
+			t.Errorf("Expected ErrValueClosed, got: %v", err)
+		}
+	})
+}
+
+func TestSessionRunAsync(t *testing.T) {
+	runtime := newTestRuntime(t)
+
+	env, err := runtime.NewEnv("test", LoggingLevelWarning)
+	if err != nil {
+		t.Fatalf("Failed to create environment: %v", err)
+	}
+	defer env.Close()
+
+	modelFile, err := os.Open(testModelPath())
+	if err != nil {
+		t.Fatalf("Failed to read model file: %v", err)
+	}
+	defer modelFile.Close()
+
+	// RunAsync requires an intra-op thread pool with at least two threads
+	session, err := runtime.NewSessionFromReader(env, modelFile, &SessionOptions{
+		IntraOpNumThreads: 2,
+	})
+	if err != nil {
+		t.Fatalf("Failed to create session: %v", err)
+	}
+	defer session.Close()
+
+	inputData := []float32{1.0, 2.0, 3.0, 4.0, 5.0, 6.0, 7.0, 8.0, 9.0, 10.0}
+	inputTensor, err := NewTensorValue(runtime, inputData, []int64{1, 10})
+	if err != nil {
+		t.Fatalf("Failed to create input tensor: %v", err)
+	}
+	defer inputTensor.Close()
+
+	outputs, err := session.Run(t.Context(), map[string]*Value{
+		"input": inputTensor,
+	})
+	if err != nil {
+		t.Fatalf("Failed to run inference: %v", err)
+	}
+	expected, _, err := GetTensorData[float32](outputs["logits"])
+	if err != nil {
+		t.Fatalf("Failed to get output data: %v", err)
+	}
+	outputs["logits"].Close()
+
+	t.Run("Concurrent", func(t *testing.T) {
+		results := make([]<-chan Result, 4)
+		for i := range results {
+			results[i] = session.RunAsync(t.Context(), map[string]*Value{
+				"input": inputTensor,
+			})
+		}
+
+		for _, result := range results {
+			res := <-result
+			if res.Err != nil {
+				t.Fatalf("Failed to run inference asynchronously: %v", res.Err)
+			}
+			assertTensorData(t, res.Outputs["logits"], expected, []int64{1, 3})
+			res.Outputs["logits"].Close()
+
+			if _, ok := <-result; ok {
+				t.Error("Expected result channel to be closed")
+			}
+		}
+	})
+
+	t.Run("Preallocated", func(t *testing.T) {
+		outputTensor, err := NewEmptyTensor[float32](runtime, []int64{1, 3})
+		if err != nil {
+			t.Fatalf("Failed to create output tensor: %v", err)
+		}
+		defer outputTensor.Close()
+
+		res := <-session.RunAsync(t.Context(), map[string]*Value{
+			"input": inputTensor,
+		}, WithOutputs(map[string]*Value{"logits": outputTensor}))
+		if res.Err != nil {
+			t.Fatalf("Failed to run inference asynchronously: %v", res.Err)
+		}
+		if res.Outputs["logits"] != outputTensor {
+			t.Fatalf("Expected the preallocated output to be returned")
+		}
+		assertTensorData(t, outputTensor, expected, []int64{1, 3})
+	})
+
+	t.Run("CanceledContext", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(t.Context())
+		cancel()
+
+		res := <-session.RunAsync(ctx, map[string]*Value{
+			"input": inputTensor,
+		})
+		if !errors.Is(res.Err, context.Canceled) {
+			t.Errorf("Expected context.Canceled, got: %v", res.Err)
+		}
+	})
+}
+
+func TestSessionRunAsyncWithClosedSession(t *testing.T) {
+	runtime := newTestRuntime(t)
+	session := newTestSession(t, runtime)
+	session.Close()
+
+	res := <-session.RunAsync(t.Context(), nil)
+	if !errors.Is(res.Err, ErrSessionClosed) {
+		t.Errorf("Expected ErrSessionClosed, got: %v", res.Err)
 	}
 }
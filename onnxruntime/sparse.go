@@ -0,0 +1,249 @@
+package onnxruntime
+
+import (
+	"fmt"
+	"slices"
+	"unsafe"
+
+	"github.com/shota3506/onnxruntime-purego/onnxruntime/internal/api"
+)
+
+// Sparse tensor indices formats (internal use only)
+const (
+	sparseIndicesCOO         api.OrtSparseIndicesFormat = 0
+	sparseIndicesCSRInner    api.OrtSparseIndicesFormat = 1
+	sparseIndicesCSROuter    api.OrtSparseIndicesFormat = 2
+	sparseIndicesBlockSparse api.OrtSparseIndicesFormat = 3
+)
+
+// NewCOOSparseTensorValue creates a new sparse tensor value in COO format.
+// values holds the non-zero values. indices holds either one linear index into
+// the flattened dense tensor per value, or for 2-D tensors a (row, column)
+// pair per value.
+// The values and indices are referenced without copying and must not be
+// modified while the value is in use.
+func NewCOOSparseTensorValue[T TensorData](r *Runtime, denseShape []int64, values []T, indices []int64) (*Value, error) {
+	v, err := newSparseTensorValue(r, denseShape, values, []int64{int64(len(values))})
+	if err != nil {
+		return nil, err
+	}
+
+	v.ownData(pinData(indices))
+
+	status := r.apiFuncs.UseCooIndices(v.ptr, unsafe.SliceData(indices), uintptr(len(indices)))
+	if err := r.statusError(status, "UseCooIndices"); err != nil {
+		v.Close()
+		return nil, fmt.Errorf("failed to use COO indices: %w", err)
+	}
+
+	return v, nil
+}
+
+// NewCSRSparseTensorValue creates a new sparse tensor value in CSR format.
+// The dense shape must be 2-D. values holds the non-zero values in row-major
+// order, innerIndices holds the column index of each value, and outerIndices
+// holds the offset of the first value of each row followed by the number of
+// values, so its length is the number of rows plus one.
+// The values and indices are referenced without copying and must not be
+// modified while the value is in use.
+func NewCSRSparseTensorValue[T TensorData](r *Runtime, denseShape []int64, values []T, innerIndices, outerIndices []int64) (*Value, error) {
+	v, err := newSparseTensorValue(r, denseShape, values, []int64{int64(len(values))})
+	if err != nil {
+		return nil, err
+	}
+
+	v.ownData(pinData(innerIndices), pinData(outerIndices))
+
+	status := r.apiFuncs.UseCsrIndices(
+		v.ptr,
+		unsafe.SliceData(innerIndices), uintptr(len(innerIndices)),
+		unsafe.SliceData(outerIndices), uintptr(len(outerIndices)),
+	)
+	if err := r.statusError(status, "UseCsrIndices"); err != nil {
+		v.Close()
+		return nil, fmt.Errorf("failed to use CSR indices: %w", err)
+	}
+
+	return v, nil
+}
+
+// NewBlockSparseTensorValue creates a new sparse tensor value in block sparse format.
+// values holds the non-zero blocks with shape valuesShape, typically
+// [numBlocks, blockRows, blockCols]. indices holds the block coordinates with
+// shape indicesShape, typically [2, numBlocks] where the first row holds the
+// block row indices and the second row the block column indices.
+// The values and indices are referenced without copying and must not be
+// modified while the value is in use.
+func NewBlockSparseTensorValue[T TensorData](r *Runtime, denseShape []int64, values []T, valuesShape []int64, indices []int32, indicesShape []int64) (*Value, error) {
+	if count := shapeElementCount(indicesShape); count != len(indices) {
+		return nil, fmt.Errorf("indices length %d does not match shape %v (%d elements)", len(indices), indicesShape, count)
+	}
+
+	v, err := newSparseTensorValue(r, denseShape, values, valuesShape)
+	if err != nil {
+		return nil, err
+	}
+
+	v.ownData(pinData(indices))
+
+	status := r.apiFuncs.UseBlockSparseIndices(v.ptr, unsafe.SliceData(indicesShape), uintptr(len(indicesShape)), unsafe.SliceData(indices))
+	if err := r.statusError(status, "UseBlockSparseIndices"); err != nil {
+		v.Close()
+		return nil, fmt.Errorf("failed to use block sparse indices: %w", err)
+	}
+
+	return v, nil
+}
+
+// newSparseTensorValue creates a sparse tensor value whose values are backed by Go memory.
+// The indices must be set with one of the Use*Indices functions before the value is used.
+func newSparseTensorValue[T TensorData](r *Runtime, denseShape []int64, values []T, valuesShape []int64) (*Value, error) {
+	dataType, _ := tensorElementDataType[T]()
+	if dataType == ONNXTensorElementDataTypeUndefined {
+		return nil, fmt.Errorf("unsupported data type")
+	}
+	if len(denseShape) == 0 {
+		return nil, fmt.Errorf("dense shape cannot be empty")
+	}
+	if count := shapeElementCount(valuesShape); count != len(values) {
+		return nil, fmt.Errorf("values length %d does not match shape %v (%d elements)", len(values), valuesShape, count)
+	}
+	if r.cpuMemoryInfo == nil {
+		return nil, fmt.Errorf("default memory info not initialized")
+	}
+
+	pinned := pinData(values)
+
+	var valuePtr api.OrtValue
+	status := r.apiFuncs.CreateSparseTensorWithValuesAsOrtValue(
+		r.cpuMemoryInfo.ptr,
+		unsafe.Pointer(unsafe.SliceData(values)),
+		&denseShape[0], uintptr(len(denseShape)),
+		unsafe.SliceData(valuesShape), uintptr(len(valuesShape)),
+		dataType,
+		&valuePtr,
+	)
+	if err := r.statusError(status, "CreateSparseTensorWithValuesAsOrtValue"); err != nil {
+		pinned.pinner.Unpin()
+		return nil, fmt.Errorf("failed to create sparse tensor: %w", err)
+	}
+
+	v := r.newValueFromPtr(valuePtr)
+	v.ownData(pinned)
+	return v, nil
+}
+
+// GetSparseTensorFormat returns the storage format of a sparse tensor value.
+func (v *Value) GetSparseTensorFormat() (SparseFormat, error) {
+	if err := v.checkOpen(); err != nil {
+		return SparseFormatUndefined, err
+	}
+
+	var format SparseFormat
+	status := v.runtime.apiFuncs.GetSparseTensorFormat(v.ptr, &format)
+	if err := v.runtime.statusError(status, "GetSparseTensorFormat"); err != nil {
+		return SparseFormatUndefined, fmt.Errorf("failed to get sparse tensor format: %w", err)
+	}
+	return format, nil
+}
+
+// GetSparseTensorValues extracts the non-zero values and their shape from a sparse tensor value.
+// The returned data slice is a copy of the values.
+// Use GetTensorShape to get the dense shape of the sparse tensor.
+func GetSparseTensorValues[T TensorData](v *Value) ([]T, []int64, error) {
+	if err := v.checkOpen(); err != nil {
+		return nil, nil, err
+	}
+
+	var infoPtr api.OrtTensorTypeAndShapeInfo
+	status := v.runtime.apiFuncs.GetSparseTensorValuesTypeAndShape(v.ptr, &infoPtr)
+	if err := v.runtime.statusError(status, "GetSparseTensorValuesTypeAndShape"); err != nil {
+		return nil, nil, fmt.Errorf("failed to get sparse tensor values type and shape: %w", err)
+	}
+	defer v.runtime.apiFuncs.ReleaseTensorTypeAndShapeInfo(infoPtr)
+
+	elemType, shape, err := v.runtime.getTensorTypeAndShape(infoPtr)
+	if err != nil {
+		return nil, nil, err
+	}
+	expectedType, _ := tensorElementDataType[T]()
+	if elemType != expectedType {
+		return nil, nil, fmt.Errorf("element type mismatch: expected %d, got %d", expectedType, elemType)
+	}
+
+	var dataPtr unsafe.Pointer
+	status = v.runtime.apiFuncs.GetSparseTensorValues(v.ptr, &dataPtr)
+	if err := v.runtime.statusError(status, "GetSparseTensorValues"); err != nil {
+		return nil, nil, fmt.Errorf("failed to get sparse tensor values: %w", err)
+	}
+
+	count := shapeElementCount(shape)
+	if count == 0 {
+		return []T{}, shape, nil
+	}
+	return slices.Clone(unsafe.Slice((*T)(dataPtr), count)), shape, nil
+}
+
+// GetSparseTensorCOOIndices returns a copy of the indices of a sparse tensor value in COO format.
+func (v *Value) GetSparseTensorCOOIndices() ([]int64, error) {
+	return getSparseTensorIndices[int64](v, sparseIndicesCOO)
+}
+
+// GetSparseTensorCSRIndices returns copies of the inner and outer indices of a
+// sparse tensor value in CSR format.
+func (v *Value) GetSparseTensorCSRIndices() (innerIndices, outerIndices []int64, err error) {
+	innerIndices, err = getSparseTensorIndices[int64](v, sparseIndicesCSRInner)
+	if err != nil {
+		return nil, nil, err
+	}
+	outerIndices, err = getSparseTensorIndices[int64](v, sparseIndicesCSROuter)
+	if err != nil {
+		return nil, nil, err
+	}
+	return innerIndices, outerIndices, nil
+}
+
+// GetSparseTensorBlockSparseIndices returns a copy of the indices and their shape
+// of a sparse tensor value in block sparse format.
+func (v *Value) GetSparseTensorBlockSparseIndices() ([]int32, []int64, error) {
+	if err := v.checkOpen(); err != nil {
+		return nil, nil, err
+	}
+
+	var infoPtr api.OrtTensorTypeAndShapeInfo
+	status := v.runtime.apiFuncs.GetSparseTensorIndicesTypeShape(v.ptr, sparseIndicesBlockSparse, &infoPtr)
+	if err := v.runtime.statusError(status, "GetSparseTensorIndicesTypeShape"); err != nil {
+		return nil, nil, fmt.Errorf("failed to get sparse tensor indices type and shape: %w", err)
+	}
+	defer v.runtime.apiFuncs.ReleaseTensorTypeAndShapeInfo(infoPtr)
+
+	_, shape, err := v.runtime.getTensorTypeAndShape(infoPtr)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	indices, err := getSparseTensorIndices[int32](v, sparseIndicesBlockSparse)
+	if err != nil {
+		return nil, nil, err
+	}
+	return indices, shape, nil
+}
+
+// getSparseTensorIndices returns a copy of the indices of the given format.
+func getSparseTensorIndices[T int32 | int64](v *Value, format api.OrtSparseIndicesFormat) ([]T, error) {
+	if err := v.checkOpen(); err != nil {
+		return nil, err
+	}
+
+	var count uintptr
+	var indicesPtr unsafe.Pointer
+	status := v.runtime.apiFuncs.GetSparseTensorIndices(v.ptr, format, &count, &indicesPtr)
+	if err := v.runtime.statusError(status, "GetSparseTensorIndices"); err != nil {
+		return nil, fmt.Errorf("failed to get sparse tensor indices: %w", err)
+	}
+
+	if count == 0 {
+		return []T{}, nil
+	}
+	return slices.Clone(unsafe.Slice((*T)(indicesPtr), count)), nil
+}
@@ -0,0 +1,210 @@
+package onnxruntime
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestNewCOOSparseTensorValue(t *testing.T) {
+	runtime := newTestRuntime(t)
+
+	t.Run("LinearIndices", func(t *testing.T) {
+		values := []float32{1.0, 2.0, 3.0}
+		indices := []int64{2, 5, 11}
+
+		tensor, err := NewCOOSparseTensorValue(runtime, []int64{3, 4}, values, indices)
+		if err != nil {
+			t.Fatalf("Failed to create sparse tensor: %v", err)
+		}
+		defer tensor.Close()
+
+		valueType, err := tensor.GetValueType()
+		if err != nil {
+			t.Fatalf("Failed to get value type: %v", err)
+		}
+		if valueType != ONNXTypeSparsetensor {
+			t.Errorf("Expected value type to be ONNXTypeSparsetensor, got %d", valueType)
+		}
+
+		format, err := tensor.GetSparseTensorFormat()
+		if err != nil {
+			t.Fatalf("Failed to get sparse format: %v", err)
+		}
+		if format != SparseFormatCOO {
+			t.Errorf("Expected COO format, got %d", format)
+		}
+
+		denseShape, err := tensor.GetTensorShape()
+		if err != nil {
+			t.Fatalf("Failed to get dense shape: %v", err)
+		}
+		if !slices.Equal(denseShape, []int64{3, 4}) {
+			t.Errorf("Dense shape mismatch: expected [3 4], got %v", denseShape)
+		}
+
+		gotValues, valuesShape, err := GetSparseTensorValues[float32](tensor)
+		if err != nil {
+			t.Fatalf("Failed to get sparse values: %v", err)
+		}
+		if !slices.Equal(gotValues, values) || !slices.Equal(valuesShape, []int64{3}) {
+			t.Errorf("Values mismatch: expected %v [3], got %v %v", values, gotValues, valuesShape)
+		}
+
+		gotIndices, err := tensor.GetSparseTensorCOOIndices()
+		if err != nil {
+			t.Fatalf("Failed to get COO indices: %v", err)
+		}
+		if !slices.Equal(gotIndices, indices) {
+			t.Errorf("Indices mismatch: expected %v, got %v", indices, gotIndices)
+		}
+	})
+
+	t.Run("CoordinateIndices", func(t *testing.T) {
+		values := []int64{7, 8}
+		indices := []int64{0, 1, 2, 3}
+
+		tensor, err := NewCOOSparseTensorValue(runtime, []int64{3, 4}, values, indices)
+		if err != nil {
+			t.Fatalf("Failed to create sparse tensor: %v", err)
+		}
+		defer tensor.Close()
+
+		gotIndices, err := tensor.GetSparseTensorCOOIndices()
+		if err != nil {
+			t.Fatalf("Failed to get COO indices: %v", err)
+		}
+		if !slices.Equal(gotIndices, indices) {
+			t.Errorf("Indices mismatch: expected %v, got %v", indices, gotIndices)
+		}
+	})
+
+	t.Run("Empty", func(t *testing.T) {
+		tensor, err := NewCOOSparseTensorValue[float32](runtime, []int64{1000}, nil, nil)
+		if err != nil {
+			t.Fatalf("Failed to create empty sparse tensor: %v", err)
+		}
+		defer tensor.Close()
+
+		gotValues, _, err := GetSparseTensorValues[float32](tensor)
+		if err != nil {
+			t.Fatalf("Failed to get sparse values: %v", err)
+		}
+		if len(gotValues) != 0 {
+			t.Errorf("Expected no values, got %v", gotValues)
+		}
+	})
+
+	t.Run("InvalidIndices", func(t *testing.T) {
+		_, err := NewCOOSparseTensorValue(runtime, []int64{3, 4}, []float32{1.0, 2.0}, []int64{1})
+		if err == nil {
+			t.Error("Expected error when indices do not match values")
+		}
+	})
+
+	t.Run("EmptyDenseShape", func(t *testing.T) {
+		_, err := NewCOOSparseTensorValue(runtime, nil, []float32{1.0}, []int64{0})
+		if err == nil {
+			t.Error("Expected error when dense shape is empty")
+		}
+	})
+}
+
+func TestNewCSRSparseTensorValue(t *testing.T) {
+	runtime := newTestRuntime(t)
+
+	// Dense:
+	// [1 0 0]
+	// [0 0 2]
+	// [0 3 4]
+	values := []float64{1.0, 2.0, 3.0, 4.0}
+	inner := []int64{0, 2, 1, 2}
+	outer := []int64{0, 1, 2, 4}
+
+	tensor, err := NewCSRSparseTensorValue(runtime, []int64{3, 3}, values, inner, outer)
+	if err != nil {
+		t.Fatalf("Failed to create sparse tensor: %v", err)
+	}
+	defer tensor.Close()
+
+	format, err := tensor.GetSparseTensorFormat()
+	if err != nil {
+		t.Fatalf("Failed to get sparse format: %v", err)
+	}
+	if format != SparseFormatCSR {
+		t.Errorf("Expected CSR format, got %d", format)
+	}
+
+	gotValues, _, err := GetSparseTensorValues[float64](tensor)
+	if err != nil {
+		t.Fatalf("Failed to get sparse values: %v", err)
+	}
+	if !slices.Equal(gotValues, values) {
+		t.Errorf("Values mismatch: expected %v, got %v", values, gotValues)
+	}
+
+	gotInner, gotOuter, err := tensor.GetSparseTensorCSRIndices()
+	if err != nil {
+		t.Fatalf("Failed to get CSR indices: %v", err)
+	}
+	if !slices.Equal(gotInner, inner) || !slices.Equal(gotOuter, outer) {
+		t.Errorf("Indices mismatch: expected %v %v, got %v %v", inner, outer, gotInner, gotOuter)
+	}
+
+	if _, err := tensor.GetSparseTensorCOOIndices(); err == nil {
+		t.Error("Expected error when getting COO indices of a CSR tensor")
+	}
+}
+
+func TestNewBlockSparseTensorValue(t *testing.T) {
+	runtime := newTestRuntime(t)
+
+	// Two 2x2 blocks on the diagonal of a 4x4 tensor
+	values := []float32{1, 2, 3, 4, 5, 6, 7, 8}
+	valuesShape := []int64{2, 2, 2}
+	indices := []int32{0, 1, 0, 1}
+	indicesShape := []int64{2, 2}
+
+	tensor, err := NewBlockSparseTensorValue(runtime, []int64{4, 4}, values, valuesShape, indices, indicesShape)
+	if err != nil {
+		t.Fatalf("Failed to create sparse tensor: %v", err)
+	}
+	defer tensor.Close()
+
+	format, err := tensor.GetSparseTensorFormat()
+	if err != nil {
+		t.Fatalf("Failed to get sparse format: %v", err)
+	}
+	if format != SparseFormatBlockSparse {
+		t.Errorf("Expected block sparse format, got %d", format)
+	}
+
+	gotValues, gotValuesShape, err := GetSparseTensorValues[float32](tensor)
+	if err != nil {
+		t.Fatalf("Failed to get sparse values: %v", err)
+	}
+	if !slices.Equal(gotValues, values) || !slices.Equal(gotValuesShape, valuesShape) {
+		t.Errorf("Values mismatch: expected %v %v, got %v %v", values, valuesShape, gotValues, gotValuesShape)
+	}
+
+	gotIndices, gotIndicesShape, err := tensor.GetSparseTensorBlockSparseIndices()
+	if err != nil {
+		t.Fatalf("Failed to get block sparse indices: %v", err)
+	}
+	if !slices.Equal(gotIndices, indices) || !slices.Equal(gotIndicesShape, indicesShape) {
+		t.Errorf("Indices mismatch: expected %v %v, got %v %v", indices, indicesShape, gotIndices, gotIndicesShape)
+	}
+}
+
+func TestGetSparseTensorValuesTypeMismatch(t *testing.T) {
+	runtime := newTestRuntime(t)
+
+	tensor, err := NewCOOSparseTensorValue(runtime, []int64{4}, []float32{1.0}, []int64{2})
+	if err != nil {
+		t.Fatalf("Failed to create sparse tensor: %v", err)
+	}
+	defer tensor.Close()
+
+	if _, _, err := GetSparseTensorValues[int64](tensor); err == nil {
+		t.Error("Expected error when getting values with mismatched type")
+	}
+}
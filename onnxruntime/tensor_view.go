@@ -0,0 +1,97 @@
+package onnxruntime
+
+import (
+	"fmt"
+	"slices"
+	"unsafe"
+)
+
+// TensorDataView is a zero-copy view of the data of a tensor value.
+// The data is backed by the native memory of the value and is only valid
+// until the value is closed.
+type TensorDataView[T TensorData] struct {
+	value *Value
+	data  []T
+	shape []int64
+}
+
+// TensorView returns a view of the tensor data of v without copying it.
+// Unlike GetTensorData, the data is not copied into Go memory, which avoids
+// the copy for large tensors such as model outputs.
+//
+// The view is invalidated when v is closed: Data panics afterwards. Slices
+// returned by Data must not be retained after v is closed.
+func TensorView[T TensorData](v *Value) (*TensorDataView[T], error) {
+	data, shape, err := tensorDataSlice[T](v)
+	if err != nil {
+		return nil, err
+	}
+	return &TensorDataView[T]{
+		value: v,
+		data:  data,
+		shape: shape,
+	}, nil
+}
+
+// Data returns the tensor data backed by the native memory of the value.
+// Writes to the returned slice modify the tensor.
+// It panics if the value has been closed.
+func (tv *TensorDataView[T]) Data() []T {
+	if !tv.Valid() {
+		panic("onnxruntime: TensorDataView used after Value.Close")
+	}
+	return tv.data
+}
+
+// Shape returns the shape of the tensor.
+func (tv *TensorDataView[T]) Shape() []int64 {
+	return slices.Clone(tv.shape)
+}
+
+// Valid reports whether the view can still be used, i.e. the value has not been closed.
+func (tv *TensorDataView[T]) Valid() bool {
+	return tv.value.ptr != 0
+}
+
+// MutableData returns the tensor data of v as a slice backed by the native
+// memory of the value, without copying it. Writes to the slice modify the
+// tensor, which allows filling inputs created by NewEmptyTensor in place.
+// The slice must not be used after v is closed.
+func MutableData[T TensorData](v *Value) ([]T, error) {
+	data, _, err := tensorDataSlice[T](v)
+	if err != nil {
+		return nil, err
+	}
+	return data, nil
+}
+
+// tensorDataSlice returns the tensor data of v as a slice backed by native memory, along with the shape.
+func tensorDataSlice[T TensorData](v *Value) ([]T, []int64, error) {
+	shape, err := v.GetTensorShape()
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to get shape: %w", err)
+	}
+
+	elemType, err := v.GetTensorElementType()
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to get element type: %w", err)
+	}
+	expectedType, _ := tensorElementDataType[T]()
+	if elemType != expectedType {
+		return nil, nil, fmt.Errorf("element type mismatch: expected %d, got %d", expectedType, elemType)
+	}
+
+	count, err := v.GetTensorElementCount()
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to get element count: %w", err)
+	}
+	if count == 0 {
+		return []T{}, shape, nil
+	}
+
+	dataPtr, err := v.getTensorMutableData()
+	if err != nil {
+		return nil, nil, err
+	}
+	return unsafe.Slice((*T)(dataPtr), count), shape, nil
+}
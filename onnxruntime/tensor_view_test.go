@@ -0,0 +1,118 @@
+package onnxruntime
+
+import (
+	"errors"
+	"slices"
+	"testing"
+)
+
+func TestTensorView(t *testing.T) {
+	runtime := newTestRuntime(t)
+
+	t.Run("Basic", func(t *testing.T) {
+		data := []float32{1.0, 2.0, 3.0, 4.0, 5.0, 6.0}
+		shape := []int64{2, 3}
+
+		tensor, err := NewTensorValue(runtime, data, shape)
+		if err != nil {
+			t.Fatalf("Failed to create tensor: %v", err)
+		}
+		defer tensor.Close()
+
+		view, err := TensorView[float32](tensor)
+		if err != nil {
+			t.Fatalf("Failed to create tensor view: %v", err)
+		}
+		if !view.Valid() {
+			t.Error("Expected view to be valid")
+		}
+		if !slices.Equal(view.Data(), data) {
+			t.Errorf("Data mismatch: expected %v, got %v", data, view.Data())
+		}
+		if !slices.Equal(view.Shape(), shape) {
+			t.Errorf("Shape mismatch: expected %v, got %v", shape, view.Shape())
+		}
+
+		// Writes through the view modify the tensor
+		view.Data()[0] = 42.0
+		assertTensorData(t, tensor, []float32{42.0, 2.0, 3.0, 4.0, 5.0, 6.0}, shape)
+	})
+
+	t.Run("TypeMismatch", func(t *testing.T) {
+		tensor, err := NewTensorValue(runtime, []float32{1.0}, []int64{1})
+		if err != nil {
+			t.Fatalf("Failed to create tensor: %v", err)
+		}
+		defer tensor.Close()
+
+		if _, err := TensorView[int64](tensor); err == nil {
+			t.Error("Expected error when creating view with mismatched type")
+		}
+	})
+
+	t.Run("UseAfterClose", func(t *testing.T) {
+		tensor, err := NewTensorValue(runtime, []int32{1, 2, 3}, []int64{3})
+		if err != nil {
+			t.Fatalf("Failed to create tensor: %v", err)
+		}
+
+		view, err := TensorView[int32](tensor)
+		if err != nil {
+			t.Fatalf("Failed to create tensor view: %v", err)
+		}
+		tensor.Close()
+
+		if view.Valid() {
+			t.Error("Expected view to be invalid after Close")
+		}
+		defer func() {
+			if recover() == nil {
+				t.Error("Expected Data to panic after Close")
+			}
+		}()
+		view.Data()
+	})
+
+	t.Run("ClosedValue", func(t *testing.T) {
+		tensor, err := NewTensorValue(runtime, []float32{1.0}, []int64{1})
+		if err != nil {
+			t.Fatalf("Failed to create tensor: %v", err)
+		}
+		tensor.Close()
+
+		if _, err := TensorView[float32](tensor); !errors.Is(err, ErrValueClosed) {
+			t.Errorf("Expected ErrValueClosed, got %v", err)
+		}
+	})
+}
+
+func TestMutableData(t *testing.T) {
+	runtime := newTestRuntime(t)
+
+	tensor, err := NewEmptyTensor[uint8](runtime, []int64{4})
+	if err != nil {
+		t.Fatalf("Failed to create empty tensor: %v", err)
+	}
+	defer tensor.Close()
+
+	// Fill the same tensor in place multiple times
+	for frame := range uint8(3) {
+		data, err := MutableData[uint8](tensor)
+		if err != nil {
+			t.Fatalf("Failed to get mutable data: %v", err)
+		}
+		for i := range data {
+			data[i] = frame
+		}
+		assertTensorData(t, tensor, []uint8{frame, frame, frame, frame}, []int64{4})
+	}
+
+	if _, err := MutableData[float32](tensor); err == nil {
+		t.Error("Expected error when getting mutable data with mismatched type")
+	}
+
+	tensor.Close()
+	if _, err := MutableData[uint8](tensor); !errors.Is(err, ErrValueClosed) {
+		t.Errorf("Expected ErrValueClosed, got %v", err)
+	}
+}
@@ -0,0 +1,75 @@
+package onnxruntime
+
+import (
+	"fmt"
+
+	"github.com/shota3506/onnxruntime-purego/internal/cstrings"
+	"github.com/shota3506/onnxruntime-purego/onnxruntime/internal/api"
+)
+
+// TensorInfo describes the type and shape of a model input or output.
+type TensorInfo struct {
+	// Name is the name of the input or output.
+	Name string
+
+	// Type is the ONNX type of the value (tensor, sequence, map, etc.).
+	// ElementType, Shape and SymbolicShape are only populated for tensors.
+	Type ONNXType
+
+	// ElementType is the data type of the tensor elements.
+	ElementType ONNXTensorElementDataType
+
+	// Shape is the tensor shape. Dynamic dimensions are reported as -1.
+	Shape []int64
+
+	// SymbolicShape holds the symbolic name of each dimension (e.g. "batch_size").
+	// Dimensions without a symbolic name are reported as an empty string.
+	SymbolicShape []string
+}
+
+// newTensorInfoFromTypeInfo builds a TensorInfo from an OrtTypeInfo.
+// The caller retains ownership of typeInfo.
+func (r *Runtime) newTensorInfoFromTypeInfo(name string, typeInfo api.OrtTypeInfo) (TensorInfo, error) {
+	info := TensorInfo{Name: name}
+
+	status := r.apiFuncs.GetOnnxTypeFromTypeInfo(typeInfo, &info.Type)
+	if err := r.statusError(status, "GetOnnxTypeFromTypeInfo"); err != nil {
+		return TensorInfo{}, fmt.Errorf("failed to get ONNX type: %w", err)
+	}
+	if info.Type != ONNXTypeTensor && info.Type != ONNXTypeSparsetensor {
+		return info, nil
+	}
+
+	// The tensor info is owned by typeInfo and must not be released.
+	var tensorInfo api.OrtTensorTypeAndShapeInfo
+	status = r.apiFuncs.CastTypeInfoToTensorInfo(typeInfo, &tensorInfo)
+	if err := r.statusError(status, "CastTypeInfoToTensorInfo"); err != nil {
+		return TensorInfo{}, fmt.Errorf("failed to cast type info to tensor info: %w", err)
+	}
+	if tensorInfo == 0 {
+		return info, nil
+	}
+
+	elemType, shape, err := r.getTensorTypeAndShape(tensorInfo)
+	if err != nil {
+		return TensorInfo{}, err
+	}
+	info.ElementType = elemType
+	info.Shape = shape
+
+	dimCount := uintptr(len(shape))
+	info.SymbolicShape = make([]string, dimCount)
+	if dimCount > 0 {
+		// The returned strings are owned by the tensor info.
+		dimParams := make([]*byte, dimCount)
+		status = r.apiFuncs.GetSymbolicDimensions(tensorInfo, &dimParams[0], dimCount)
+		if err := r.statusError(status, "GetSymbolicDimensions"); err != nil {
+			return TensorInfo{}, fmt.Errorf("failed to get symbolic dimensions: %w", err)
+		}
+		for i, p := range dimParams {
+			info.SymbolicShape[i] = cstrings.CStringToString(p)
+		}
+	}
+
+	return info, nil
+}
@@ -3,6 +3,8 @@ package onnxruntime
 import (
 	"fmt"
 	"runtime"
+	"strings"
+	"sync/atomic"
 	"unsafe"
 
 	"github.com/shota3506/onnxruntime-purego/onnxruntime/internal/api"
@@ -10,32 +12,51 @@ import (
 
 // TensorData is a type constraint for supported tensor data types.
 // It includes all numeric types, bool, and complex types that are supported by ONNX Runtime.
+// Half-precision tensors use Float16 and BFloat16, which satisfy the constraint via ~uint16.
 type TensorData interface {
 	~float32 | ~float64 |
 		~int8 | ~int16 | ~int32 | ~int64 |
 		~uint8 | ~uint16 | ~uint32 | ~uint64 |
-		~bool
+		~bool |
+		~complex64 | ~complex128
 }
 
 // Value represents an ONNX Runtime value, typically a tensor.
 // Values are used as inputs and outputs for model inference.
+// A Value must be closed when no longer needed; its native memory and any Go
+// memory it references are not released when it becomes unreachable.
 type Value struct {
 	ptr     api.OrtValue
 	infoPtr api.OrtTensorTypeAndShapeInfo
 	runtime *Runtime
+
+	// pinned holds Go memory referenced by the native value, either directly
+	// or through the elements of a sequence or map. It is kept in place
+	// until the value is closed.
+	pinned []*pinnedData
+
+	// emptyOptional indicates an optional value without an element
+	// created by NewEmptyOptionalValue. It has no native value.
+	emptyOptional bool
 }
 
 func (r *Runtime) newValueFromPtr(ptr api.OrtValue) *Value {
-	v := &Value{
+	return &Value{
 		ptr:     ptr,
 		runtime: r,
 	}
+}
 
-	// Clean up resources when the Value is no longer reachable.
-	runtime.AddCleanup(v, func(_ struct{}) {
-		v.Close()
-	}, struct{}{})
-	return v
+// checkOpen returns an error if the value has no native value,
+// either because it has been closed or because it is an empty optional.
+func (v *Value) checkOpen() error {
+	if v.ptr != 0 {
+		return nil
+	}
+	if v.emptyOptional {
+		return ErrEmptyOptional
+	}
+	return ErrValueClosed
 }
 
 func (v *Value) initTensorTypeAndShapeInfo() error {
@@ -43,10 +64,13 @@ func (v *Value) initTensorTypeAndShapeInfo() error {
 		// already initialized
 		return nil
 	}
+	if err := v.checkOpen(); err != nil {
+		return err
+	}
 
 	var infoPtr api.OrtTensorTypeAndShapeInfo
 	status := v.runtime.apiFuncs.GetTensorTypeAndShape(v.ptr, &infoPtr)
-	if err := v.runtime.statusError(status); err != nil {
+	if err := v.runtime.statusError(status, "GetTensorTypeAndShape"); err != nil {
 		return fmt.Errorf("failed to get tensor type and shape: %w", err)
 	}
 	v.infoPtr = infoPtr
@@ -55,9 +79,13 @@ func (v *Value) initTensorTypeAndShapeInfo() error {
 
 // getTensorMutableData returns a pointer to the tensor's underlying data buffer.
 func (v *Value) getTensorMutableData() (unsafe.Pointer, error) {
+	if err := v.checkOpen(); err != nil {
+		return nil, err
+	}
+
 	var dataPtr unsafe.Pointer
 	status := v.runtime.apiFuncs.GetTensorMutableData(v.ptr, &dataPtr)
-	if err := v.runtime.statusError(status); err != nil {
+	if err := v.runtime.statusError(status, "GetTensorMutableData"); err != nil {
 		return nil, fmt.Errorf("failed to get tensor data: %w", err)
 	}
 
@@ -65,35 +93,142 @@ func (v *Value) getTensorMutableData() (unsafe.Pointer, error) {
 }
 
 // GetValueType returns the type of the value (tensor, sequence, map, etc.).
+// An optional without an element is reported as ONNXTypeOptional.
 func (v *Value) GetValueType() (ONNXType, error) {
+	if v.emptyOptional {
+		return ONNXTypeOptional, nil
+	}
+	if err := v.checkOpen(); err != nil {
+		return ONNXTypeUnknown, err
+	}
+
 	var valueType ONNXType
 	status := v.runtime.apiFuncs.GetValueType(v.ptr, &valueType)
-	if err := v.runtime.statusError(status); err != nil {
+	if err := v.runtime.statusError(status, "GetValueType"); err != nil {
 		return ONNXTypeUnknown, fmt.Errorf("failed to get value type: %w", err)
 	}
 
+	// ONNX Runtime returns an optional output without an element as a value
+	// that holds no data, which it reports as an unknown type.
+	if valueType == ONNXTypeUnknown {
+		ok, err := v.HasValue()
+		if err != nil {
+			return ONNXTypeUnknown, err
+		}
+		if !ok {
+			return ONNXTypeOptional, nil
+		}
+	}
+
 	return valueType, nil
 }
 
+// checkValueType returns an error if the value is not of the expected type.
+func (v *Value) checkValueType(expected ONNXType) error {
+	valueType, err := v.GetValueType()
+	if err != nil {
+		return err
+	}
+	if valueType != expected {
+		return fmt.Errorf("value type mismatch: expected %d, got %d", expected, valueType)
+	}
+	return nil
+}
+
+// getValueCount returns the number of elements in a sequence value,
+// or 2 (keys and values) for a map value.
+func (v *Value) getValueCount() (int, error) {
+	var count uintptr
+	status := v.runtime.apiFuncs.GetValueCount(v.ptr, &count)
+	if err := v.runtime.statusError(status, "GetValueCount"); err != nil {
+		return 0, fmt.Errorf("failed to get value count: %w", err)
+	}
+	return int(count), nil
+}
+
+// getValue returns the element at index of a sequence value, or the keys (0)
+// and values (1) tensors of a map value. The returned value is owned by the
+// caller and must be closed.
+func (v *Value) getValue(index int) (*Value, error) {
+	var valuePtr api.OrtValue
+	status := v.runtime.apiFuncs.GetValue(v.ptr, int32(index), v.runtime.allocator.ptr, &valuePtr)
+	if err := v.runtime.statusError(status, "GetValue"); err != nil {
+		return nil, fmt.Errorf("failed to get value at index %d: %w", index, err)
+	}
+	return v.runtime.newValueFromPtr(valuePtr), nil
+}
+
+// newValueFromValues creates a sequence or map value from the given values.
+// The given values remain owned by the caller. ONNX Runtime may share their
+// buffers instead of copying them, as it does for tensor sequences, so the new
+// value also references the Go memory of the given values until it is closed.
+func (r *Runtime) newValueFromValues(values []*Value, valueType ONNXType) (*Value, error) {
+	ptrs := make([]api.OrtValue, len(values))
+	for i, v := range values {
+		if v == nil || v.ptr == 0 {
+			return nil, fmt.Errorf("value at index %d is nil or closed", i)
+		}
+		ptrs[i] = v.ptr
+	}
+
+	var valuePtr api.OrtValue
+	status := r.apiFuncs.CreateValue(&ptrs[0], uintptr(len(ptrs)), valueType, &valuePtr)
+	if err := r.statusError(status, "CreateValue"); err != nil {
+		return nil, fmt.Errorf("failed to create value: %w", err)
+	}
+
+	value := r.newValueFromPtr(valuePtr)
+	for _, v := range values {
+		value.ownData(v.pinned...)
+	}
+	return value, nil
+}
+
 // GetTensorShape returns the shape (dimensions) of the tensor as a slice of int64 values.
 // For example, a 2x3 matrix returns [2, 3].
 func (v *Value) GetTensorShape() ([]int64, error) {
 	if err := v.initTensorTypeAndShapeInfo(); err != nil {
 		return nil, err
 	}
+	return v.runtime.getTensorShape(v.infoPtr)
+}
+
+// getTensorTypeAndShape reads the element type and shape from a tensor type and shape info.
+func (r *Runtime) getTensorTypeAndShape(infoPtr api.OrtTensorTypeAndShapeInfo) (ONNXTensorElementDataType, []int64, error) {
+	elemType, err := r.getTensorElementType(infoPtr)
+	if err != nil {
+		return ONNXTensorElementDataTypeUndefined, nil, err
+	}
+
+	shape, err := r.getTensorShape(infoPtr)
+	if err != nil {
+		return ONNXTensorElementDataTypeUndefined, nil, err
+	}
+	return elemType, shape, nil
+}
 
-	// Get dimension count
+// getTensorElementType reads the element type from a tensor type and shape info.
+func (r *Runtime) getTensorElementType(infoPtr api.OrtTensorTypeAndShapeInfo) (ONNXTensorElementDataType, error) {
+	var elemType ONNXTensorElementDataType
+	status := r.apiFuncs.GetTensorElementType(infoPtr, &elemType)
+	if err := r.statusError(status, "GetTensorElementType"); err != nil {
+		return ONNXTensorElementDataTypeUndefined, fmt.Errorf("failed to get element type: %w", err)
+	}
+	return elemType, nil
+}
+
+// getTensorShape reads the shape from a tensor type and shape info.
+func (r *Runtime) getTensorShape(infoPtr api.OrtTensorTypeAndShapeInfo) ([]int64, error) {
 	var dimCount uintptr
-	status := v.runtime.apiFuncs.GetDimensionsCount(v.infoPtr, &dimCount)
-	if err := v.runtime.statusError(status); err != nil {
+	status := r.apiFuncs.GetDimensionsCount(infoPtr, &dimCount)
+	if err := r.statusError(status, "GetDimensionsCount"); err != nil {
 		return nil, fmt.Errorf("failed to get dimensions count: %w", err)
 	}
 
-	// Get dimensions
 	dims := make([]int64, dimCount)
 	if dimCount > 0 {
-		status = v.runtime.apiFuncs.GetDimensions(v.infoPtr, &dims[0], dimCount)
-		if err := v.runtime.statusError(status); err != nil {
+		status = r.apiFuncs.GetDimensions(infoPtr, &dims[0], dimCount)
+		if err := r.statusError(status, "GetDimensions"); err != nil {
 			return nil, fmt.Errorf("failed to get dimensions: %w", err)
 		}
 	}
@@ -106,14 +241,7 @@ func (v *Value) GetTensorElementType() (ONNXTensorElementDataType, error) {
 	if err := v.initTensorTypeAndShapeInfo(); err != nil {
 		return ONNXTensorElementDataTypeUndefined, err
 	}
-
-	var elemType ONNXTensorElementDataType
-	status := v.runtime.apiFuncs.GetTensorElementType(v.infoPtr, &elemType)
-	if err := v.runtime.statusError(status); err != nil {
-		return ONNXTensorElementDataTypeUndefined, fmt.Errorf("failed to get element type: %w", err)
-	}
-
-	return elemType, nil
+	return v.runtime.getTensorElementType(v.infoPtr)
 }
 
 // GetElementCount returns the total number of elements in the tensor.
@@ -125,7 +253,7 @@ func (v *Value) GetTensorElementCount() (int, error) {
 
 	var count uintptr
 	status := v.runtime.apiFuncs.GetTensorShapeElementCount(v.infoPtr, &count)
-	if err := v.runtime.statusError(status); err != nil {
+	if err := v.runtime.statusError(status, "GetTensorShapeElementCount"); err != nil {
 		return 0, fmt.Errorf("failed to get element count: %w", err)
 	}
 
@@ -135,13 +263,13 @@ func (v *Value) GetTensorElementCount() (int, error) {
 // Close releases the value and associated resources.
 // It is safe to call Close multiple times.
 //
-// While a finalizer is set as a safety net to automatically release resources
-// when the Value is garbage collected, explicitly calling Close is strongly
-// recommended to ensure timely release of native memory, especially when
-// dealing with large tensors or high-frequency inference operations.
+// Close must be called for every value, including the outputs returned by
+// Session.Run. A value that is not closed leaks its native memory and keeps
+// the Go memory it references pinned.
 func (v *Value) Close() {
 	v.releaseValue()
 	v.releaseInfo()
+	v.releaseData()
 }
 
 func (v *Value) releaseValue() {
@@ -151,6 +279,59 @@ func (v *Value) releaseValue() {
 	}
 }
 
+// releaseData drops the references to the Go memory used by the released native value.
+func (v *Value) releaseData() {
+	for _, p := range v.pinned {
+		p.release()
+	}
+	v.pinned = nil
+}
+
+// ownData makes the value reference pinned Go memory, keeping it pinned
+// and reachable until the value is closed.
+func (v *Value) ownData(pinned ...*pinnedData) {
+	for _, p := range pinned {
+		v.pinned = append(v.pinned, p.acquire())
+	}
+}
+
+// pinnedData is Go memory referenced by native values. Values sharing the
+// same native buffers, such as a sequence and its elements, share it, and it
+// is unpinned once all of them are closed.
+type pinnedData struct {
+	pinner runtime.Pinner
+	data   []any
+	refs   atomic.Int32
+}
+
+// pinData pins the backing array of data for use by native values.
+func pinData[T any](data []T) *pinnedData {
+	p := &pinnedData{data: []any{data}}
+	pinSlice(&p.pinner, data)
+	return p
+}
+
+// acquire adds a reference and returns p.
+func (p *pinnedData) acquire() *pinnedData {
+	p.refs.Add(1)
+	return p
+}
+
+// release drops a reference, unpinning the memory when none is left.
+func (p *pinnedData) release() {
+	if p.refs.Add(-1) == 0 {
+		p.pinner.Unpin()
+		p.data = nil
+	}
+}
+
+// pinSlice pins the backing array of data, if any.
+func pinSlice[T any](pinner *runtime.Pinner, data []T) {
+	if len(data) > 0 {
+		pinner.Pin(&data[0])
+	}
+}
+
 func (v *Value) releaseInfo() {
 	if v.infoPtr != 0 && v.runtime != nil && v.runtime.apiFuncs != nil {
 		v.runtime.apiFuncs.ReleaseTensorTypeAndShapeInfo(v.infoPtr)
@@ -161,58 +342,130 @@ func (v *Value) releaseInfo() {
 // NewTensorValue creates a new tensor value from a slice of data using type inference.
 // This is a generic function that supports all numeric types and bool via the TensorData constraint.
 // The data slice must not be empty, and the shape defines the tensor dimensions.
-func NewTensorValue[T TensorData](r *Runtime, data []T, shape []int64) (*Value, error) {
+//
+// By default the tensor references data without copying it. The value pins
+// data and keeps it reachable until it is closed, so data must not be modified
+// while the value is in use. Use WithCopy to copy data into memory allocated by
+// ONNX Runtime instead, so that data can be reused as soon as NewTensorValue returns.
+func NewTensorValue[T TensorData](r *Runtime, data []T, shape []int64, opts ...TensorOption) (*Value, error) {
 	if len(data) == 0 {
 		return nil, fmt.Errorf("data cannot be empty")
 	}
 
-	var dataType ONNXTensorElementDataType
-	var elementSize uintptr
+	dataType, elementSize := tensorElementDataType[T]()
+	if dataType == ONNXTensorElementDataTypeUndefined {
+		return nil, fmt.Errorf("unsupported data type")
+	}
+
+	config := &tensorConfig{}
+	for _, opt := range opts {
+		opt(config)
+	}
+
+	if config.copyData {
+		if count := shapeElementCount(shape); count != len(data) {
+			return nil, fmt.Errorf("data length %d does not match shape %v (%d elements)", len(data), shape, count)
+		}
+
+		value, err := NewEmptyTensor[T](r, shape)
+		if err != nil {
+			return nil, err
+		}
+		dst, err := MutableData[T](value)
+		if err != nil {
+			value.Close()
+			return nil, err
+		}
+		copy(dst, data)
+		return value, nil
+	}
+
+	pinned := pinData(data)
 
-	// Determine data type and element size based on the type parameter
+	dataPtr := unsafe.Pointer(&data[0])
+	dataLen := uintptr(len(data)) * elementSize
+
+	value, err := r.newTensorValue(dataPtr, dataLen, shape, dataType)
+	if err != nil {
+		pinned.pinner.Unpin()
+		return nil, err
+	}
+	value.ownData(pinned)
+	return value, nil
+}
+
+// NewEmptyTensor creates a new tensor value of the given shape backed by memory
+// allocated by ONNX Runtime with the default allocator.
+// The contents are uninitialized; use MutableData to fill them in place.
+func NewEmptyTensor[T TensorData](r *Runtime, shape []int64) (*Value, error) {
+	dataType, _ := tensorElementDataType[T]()
+	if dataType == ONNXTensorElementDataTypeUndefined {
+		return nil, fmt.Errorf("unsupported data type")
+	}
+	for _, dim := range shape {
+		if dim < 0 {
+			return nil, fmt.Errorf("invalid shape %v: dimensions must not be negative", shape)
+		}
+	}
+
+	return r.newAllocatedTensorValue(shape, dataType)
+}
+
+// tensorConfig holds configuration for creating a tensor value.
+type tensorConfig struct {
+	copyData bool
+}
+
+// TensorOption is a functional option for NewTensorValue.
+type TensorOption func(*tensorConfig)
+
+// WithCopy copies the data into memory allocated by ONNX Runtime instead of
+// referencing the caller's slice.
+func WithCopy() TensorOption {
+	return func(c *tensorConfig) {
+		c.copyData = true
+	}
+}
+
+// tensorElementDataType returns the tensor element data type and element size
+// in bytes corresponding to T. It returns ONNXTensorElementDataTypeUndefined
+// if T is not supported.
+func tensorElementDataType[T TensorData]() (ONNXTensorElementDataType, uintptr) {
 	var zero T
 	switch any(zero).(type) {
 	case float32:
-		dataType = ONNXTensorElementDataTypeFloat
-		elementSize = 4
+		return ONNXTensorElementDataTypeFloat, 4
 	case float64:
-		dataType = ONNXTensorElementDataTypeDouble
-		elementSize = 8
+		return ONNXTensorElementDataTypeDouble, 8
 	case int8:
-		dataType = ONNXTensorElementDataTypeInt8
-		elementSize = 1
+		return ONNXTensorElementDataTypeInt8, 1
 	case int16:
-		dataType = ONNXTensorElementDataTypeInt16
-		elementSize = 2
+		return ONNXTensorElementDataTypeInt16, 2
 	case int32:
-		dataType = ONNXTensorElementDataTypeInt32
-		elementSize = 4
+		return ONNXTensorElementDataTypeInt32, 4
 	case int64:
-		dataType = ONNXTensorElementDataTypeInt64
-		elementSize = 8
+		return ONNXTensorElementDataTypeInt64, 8
 	case uint8:
-		dataType = ONNXTensorElementDataTypeUint8
-		elementSize = 1
+		return ONNXTensorElementDataTypeUint8, 1
 	case uint16:
-		dataType = ONNXTensorElementDataTypeUint16
-		elementSize = 2
+		return ONNXTensorElementDataTypeUint16, 2
 	case uint32:
-		dataType = ONNXTensorElementDataTypeUint32
-		elementSize = 4
+		return ONNXTensorElementDataTypeUint32, 4
 	case uint64:
-		dataType = ONNXTensorElementDataTypeUint64
-		elementSize = 8
+		return ONNXTensorElementDataTypeUint64, 8
 	case bool:
-		dataType = ONNXTensorElementDataTypeBool
-		elementSize = 1
+		return ONNXTensorElementDataTypeBool, 1
+	case Float16:
+		return ONNXTensorElementDataTypeFloat16, 2
+	case BFloat16:
+		return ONNXTensorElementDataTypeBFloat16, 2
+	case complex64:
+		return ONNXTensorElementDataTypeComplex64, 8
+	case complex128:
+		return ONNXTensorElementDataTypeComplex128, 16
 	default:
-		return nil, fmt.Errorf("unsupported data type")
+		return ONNXTensorElementDataTypeUndefined, 0
 	}
-
-	dataPtr := unsafe.Pointer(&data[0])
-	dataLen := uintptr(len(data)) * elementSize
-
-	return r.newTensorValue(dataPtr, dataLen, shape, dataType)
 }
 
 // newTensorValue creates a new tensor value from raw data using default CPU memory.
@@ -230,7 +483,7 @@ func (r *Runtime) newTensorValue(data unsafe.Pointer, dataLen uintptr, shape []i
 	}
 
 	status := r.apiFuncs.CreateTensorWithDataAsOrtValue(r.cpuMemoryInfo.ptr, data, dataLen, shapePtr, uintptr(len(shape)), dataType, &valuePtr)
-	if err := r.statusError(status); err != nil {
+	if err := r.statusError(status, "CreateTensorWithDataAsOrtValue"); err != nil {
 		return nil, fmt.Errorf("failed to create tensor: %w", err)
 	}
 	return r.newValueFromPtr(valuePtr), nil
@@ -253,33 +506,7 @@ func GetTensorData[T TensorData](v *Value) ([]T, []int64, error) {
 		return nil, nil, fmt.Errorf("failed to get element type: %w", err)
 	}
 
-	var zero T
-	var expectedType ONNXTensorElementDataType
-	switch any(zero).(type) {
-	case float32:
-		expectedType = ONNXTensorElementDataTypeFloat
-	case float64:
-		expectedType = ONNXTensorElementDataTypeDouble
-	case int8:
-		expectedType = ONNXTensorElementDataTypeInt8
-	case int16:
-		expectedType = ONNXTensorElementDataTypeInt16
-	case int32:
-		expectedType = ONNXTensorElementDataTypeInt32
-	case int64:
-		expectedType = ONNXTensorElementDataTypeInt64
-	case uint8:
-		expectedType = ONNXTensorElementDataTypeUint8
-	case uint16:
-		expectedType = ONNXTensorElementDataTypeUint16
-	case uint32:
-		expectedType = ONNXTensorElementDataTypeUint32
-	case uint64:
-		expectedType = ONNXTensorElementDataTypeUint64
-	case bool:
-		expectedType = ONNXTensorElementDataTypeBool
-	}
-
+	expectedType, _ := tensorElementDataType[T]()
 	if elemType != expectedType {
 		return nil, nil, fmt.Errorf("element type mismatch: expected %d, got %d", expectedType, elemType)
 	}
@@ -304,3 +531,123 @@ func GetTensorData[T TensorData](v *Value) ([]T, []int64, error) {
 
 	return result, shape, nil
 }
+
+// NewStringTensorValue creates a new string tensor value from a slice of strings.
+// The data slice must not be empty and its length must match the number of
+// elements defined by shape. Strings must not contain NUL bytes.
+// The tensor data is copied into memory allocated by ONNX Runtime.
+func NewStringTensorValue(r *Runtime, data []string, shape []int64) (*Value, error) {
+	if len(data) == 0 {
+		return nil, fmt.Errorf("data cannot be empty")
+	}
+	if count := shapeElementCount(shape); count != len(data) {
+		return nil, fmt.Errorf("data length %d does not match shape %v (%d elements)", len(data), shape, count)
+	}
+
+	// Convert Go strings to null-terminated C strings
+	ptrs := make([]*byte, len(data))
+	for i, s := range data {
+		if strings.IndexByte(s, 0) >= 0 {
+			return nil, fmt.Errorf("string at index %d contains a NUL byte", i)
+		}
+		b := append([]byte(s), 0)
+		ptrs[i] = &b[0]
+	}
+
+	value, err := r.newAllocatedTensorValue(shape, ONNXTensorElementDataTypeString)
+	if err != nil {
+		return nil, err
+	}
+
+	status := r.apiFuncs.FillStringTensor(value.ptr, &ptrs[0], uintptr(len(ptrs)))
+	if err := r.statusError(status, "FillStringTensor"); err != nil {
+		value.Close()
+		return nil, fmt.Errorf("failed to fill string tensor: %w", err)
+	}
+
+	return value, nil
+}
+
+// newAllocatedTensorValue creates a new tensor value backed by memory allocated
+// by the default allocator.
+func (r *Runtime) newAllocatedTensorValue(shape []int64, dataType ONNXTensorElementDataType) (*Value, error) {
+	if r.allocator == nil {
+		return nil, fmt.Errorf("default allocator not initialized")
+	}
+
+	var valuePtr api.OrtValue
+	var shapePtr *int64
+	if len(shape) > 0 {
+		shapePtr = &shape[0]
+	}
+
+	status := r.apiFuncs.CreateTensorAsOrtValue(r.allocator.ptr, shapePtr, uintptr(len(shape)), dataType, &valuePtr)
+	if err := r.statusError(status, "CreateTensorAsOrtValue"); err != nil {
+		return nil, fmt.Errorf("failed to create tensor: %w", err)
+	}
+	return r.newValueFromPtr(valuePtr), nil
+}
+
+// GetStringTensorData extracts string tensor data and shape from a Value.
+// It returns both the data as a slice and the shape of the tensor.
+func GetStringTensorData(v *Value) ([]string, []int64, error) {
+	shape, err := v.GetTensorShape()
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to get shape: %w", err)
+	}
+
+	elemType, err := v.GetTensorElementType()
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to get element type: %w", err)
+	}
+	if elemType != ONNXTensorElementDataTypeString {
+		return nil, nil, fmt.Errorf("element type mismatch: expected %d, got %d", ONNXTensorElementDataTypeString, elemType)
+	}
+
+	count, err := v.GetTensorElementCount()
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to get element count: %w", err)
+	}
+	if count == 0 {
+		return []string{}, shape, nil
+	}
+
+	var dataLen uintptr
+	status := v.runtime.apiFuncs.GetStringTensorDataLength(v.ptr, &dataLen)
+	if err := v.runtime.statusError(status, "GetStringTensorDataLength"); err != nil {
+		return nil, nil, fmt.Errorf("failed to get string tensor data length: %w", err)
+	}
+
+	// The content buffer holds all strings concatenated without terminators,
+	// and offsets holds the start of each string in the buffer.
+	content := make([]byte, dataLen)
+	var contentPtr unsafe.Pointer
+	if dataLen > 0 {
+		contentPtr = unsafe.Pointer(&content[0])
+	}
+	offsets := make([]uintptr, count)
+	status = v.runtime.apiFuncs.GetStringTensorContent(v.ptr, contentPtr, dataLen, &offsets[0], uintptr(count))
+	if err := v.runtime.statusError(status, "GetStringTensorContent"); err != nil {
+		return nil, nil, fmt.Errorf("failed to get string tensor content: %w", err)
+	}
+
+	result := make([]string, count)
+	for i, start := range offsets {
+		end := dataLen
+		if i+1 < count {
+			end = offsets[i+1]
+		}
+		result[i] = string(content[start:end])
+	}
+
+	return result, shape, nil
+}
+
+// shapeElementCount returns the number of elements of a tensor with the given shape.
+func shapeElementCount(shape []int64) int {
+	count := 1
+	for _, dim := range shape {
+		count *= int(dim)
+	}
+	return count
+}
@@ -425,6 +425,142 @@ func TestNewTensorValue(t *testing.T) {
 		assertTensorData(t, tensor, data, shape)
 	})
 
+	t.Run("Float16", func(t *testing.T) {
+		data := Float32ToFloat16Slice([]float32{1.0, -2.5, 0.125})
+		shape := []int64{3}
+
+		tensor, err := NewTensorValue(runtime, data, shape)
+		if err != nil {
+			t.Fatalf("Failed to create float16 tensor: %v", err)
+		}
+		defer tensor.Close()
+
+		elemType, err := tensor.GetTensorElementType()
+		if err != nil {
+			t.Fatalf("Failed to get element type: %v", err)
+		}
+		if elemType != ONNXTensorElementDataTypeFloat16 {
+			t.Errorf("Expected float16 type, got %d", elemType)
+		}
+
+		// Verify data
+		assertTensorData(t, tensor, data, shape)
+	})
+
+	t.Run("BFloat16", func(t *testing.T) {
+		data := Float32ToBFloat16Slice([]float32{1.0, -2.5, 0.125})
+		shape := []int64{3}
+
+		tensor, err := NewTensorValue(runtime, data, shape)
+		if err != nil {
+			t.Fatalf("Failed to create bfloat16 tensor: %v", err)
+		}
+		defer tensor.Close()
+
+		elemType, err := tensor.GetTensorElementType()
+		if err != nil {
+			t.Fatalf("Failed to get element type: %v", err)
+		}
+		if elemType != ONNXTensorElementDataTypeBFloat16 {
+			t.Errorf("Expected bfloat16 type, got %d", elemType)
+		}
+
+		// Verify data
+		assertTensorData(t, tensor, data, shape)
+	})
+
+	t.Run("Complex64", func(t *testing.T) {
+		data := []complex64{1 + 2i, -0.5 - 1.5i, 3}
+		shape := []int64{3}
+
+		tensor, err := NewTensorValue(runtime, data, shape)
+		if err != nil {
+			t.Fatalf("Failed to create complex64 tensor: %v", err)
+		}
+		defer tensor.Close()
+
+		elemType, err := tensor.GetTensorElementType()
+		if err != nil {
+			t.Fatalf("Failed to get element type: %v", err)
+		}
+		if elemType != ONNXTensorElementDataTypeComplex64 {
+			t.Errorf("Expected complex64 type, got %d", elemType)
+		}
+
+		// Verify data
+		assertTensorData(t, tensor, data, shape)
+	})
+
+	t.Run("Complex128", func(t *testing.T) {
+		data := []complex128{1 + 2i, -0.5 - 1.5i, 3}
+		shape := []int64{3}
+
+		tensor, err := NewTensorValue(runtime, data, shape)
+		if err != nil {
+			t.Fatalf("Failed to create complex128 tensor: %v", err)
+		}
+		defer tensor.Close()
+
+		elemType, err := tensor.GetTensorElementType()
+		if err != nil {
+			t.Fatalf("Failed to get element type: %v", err)
+		}
+		if elemType != ONNXTensorElementDataTypeComplex128 {
+			t.Errorf("Expected complex128 type, got %d", elemType)
+		}
+
+		// Verify data
+		assertTensorData(t, tensor, data, shape)
+	})
+
+	t.Run("OwnsData", func(t *testing.T) {
+		data := []float32{1.0, 2.0, 3.0}
+		shape := []int64{3}
+
+		tensor, err := NewTensorValue(runtime, data, shape)
+		if err != nil {
+			t.Fatalf("Failed to create tensor: %v", err)
+		}
+		if len(tensor.pinned) == 0 {
+			t.Error("Expected tensor to pin and reference its data")
+		}
+
+		// The tensor references data, so updates are visible through it
+		data[0] = 42.0
+		assertTensorData(t, tensor, []float32{42.0, 2.0, 3.0}, shape)
+
+		tensor.Close()
+		if tensor.pinned != nil {
+			t.Error("Expected data to be released after Close()")
+		}
+	})
+
+	t.Run("WithCopy", func(t *testing.T) {
+		data := []int32{1, 2, 3, 4}
+		shape := []int64{2, 2}
+
+		tensor, err := NewTensorValue(runtime, data, shape, WithCopy())
+		if err != nil {
+			t.Fatalf("Failed to create tensor: %v", err)
+		}
+		defer tensor.Close()
+
+		if tensor.pinned != nil {
+			t.Error("Expected copied tensor not to reference Go memory")
+		}
+
+		// The caller's buffer can be reused right away
+		data[0] = 100
+		assertTensorData(t, tensor, []int32{1, 2, 3, 4}, shape)
+	})
+
+	t.Run("WithCopyShapeMismatch", func(t *testing.T) {
+		_, err := NewTensorValue(runtime, []float32{1.0, 2.0}, []int64{3}, WithCopy())
+		if err == nil {
+			t.Error("Expected error when data length does not match shape")
+		}
+	})
+
 	t.Run("EmptyData", func(t *testing.T) {
 		// Test with empty float32 slice
 		_, err := NewTensorValue(runtime, []float32{}, []int64{0})
@@ -573,3 +709,129 @@ func TestGetTensorData(t *testing.T) {
 		assertTensorData(t, tensor, originalData, originalShape)
 	})
 }
+
+func TestNewStringTensorValue(t *testing.T) {
+	runtime := newTestRuntime(t)
+
+	t.Run("Basic", func(t *testing.T) {
+		data := []string{"hello", "", "onnx runtime", "こんにちは"}
+		shape := []int64{2, 2}
+
+		tensor, err := NewStringTensorValue(runtime, data, shape)
+		if err != nil {
+			t.Fatalf("Failed to create string tensor: %v", err)
+		}
+		defer tensor.Close()
+
+		elemType, err := tensor.GetTensorElementType()
+		if err != nil {
+			t.Fatalf("Failed to get element type: %v", err)
+		}
+		if elemType != ONNXTensorElementDataTypeString {
+			t.Errorf("Expected string type, got %d", elemType)
+		}
+
+		retrievedData, retrievedShape, err := GetStringTensorData(tensor)
+		if err != nil {
+			t.Fatalf("Failed to get string tensor data: %v", err)
+		}
+		if !slices.Equal(retrievedShape, shape) {
+			t.Errorf("Shape mismatch: expected %v, got %v", shape, retrievedShape)
+		}
+		if !slices.Equal(retrievedData, data) {
+			t.Errorf("Data mismatch: expected %q, got %q", data, retrievedData)
+		}
+	})
+
+	t.Run("EmptyData", func(t *testing.T) {
+		_, err := NewStringTensorValue(runtime, []string{}, []int64{0})
+		if err == nil {
+			t.Error("Expected error when creating string tensor with empty data")
+		}
+	})
+
+	t.Run("ShapeMismatch", func(t *testing.T) {
+		_, err := NewStringTensorValue(runtime, []string{"a", "b", "c"}, []int64{2, 2})
+		if err == nil {
+			t.Error("Expected error when data length does not match shape")
+		}
+	})
+
+	t.Run("NulByte", func(t *testing.T) {
+		_, err := NewStringTensorValue(runtime, []string{"a", "b\x00c"}, []int64{2})
+		if err == nil {
+			t.Error("Expected error when a string contains a NUL byte")
+		}
+	})
+
+	t.Run("TypeMismatch", func(t *testing.T) {
+		tensor, err := NewTensorValue(runtime, []float32{1.0, 2.0}, []int64{2})
+		if err != nil {
+			t.Fatalf("Failed to create tensor: %v", err)
+		}
+		defer tensor.Close()
+
+		_, _, err = GetStringTensorData(tensor)
+		if err == nil {
+			t.Error("Expected error when extracting float32 tensor as string")
+		}
+	})
+}
+
+func TestNewEmptyTensor(t *testing.T) {
+	runtime := newTestRuntime(t)
+
+	t.Run("Basic", func(t *testing.T) {
+		shape := []int64{2, 3}
+
+		tensor, err := NewEmptyTensor[float32](runtime, shape)
+		if err != nil {
+			t.Fatalf("Failed to create empty tensor: %v", err)
+		}
+		defer tensor.Close()
+
+		elemType, err := tensor.GetTensorElementType()
+		if err != nil {
+			t.Fatalf("Failed to get element type: %v", err)
+		}
+		if elemType != ONNXTensorElementDataTypeFloat {
+			t.Errorf("Expected float32 type, got %d", elemType)
+		}
+
+		data, err := MutableData[float32](tensor)
+		if err != nil {
+			t.Fatalf("Failed to get mutable data: %v", err)
+		}
+		if len(data) != 6 {
+			t.Fatalf("Expected 6 elements, got %d", len(data))
+		}
+		for i := range data {
+			data[i] = float32(i)
+		}
+
+		assertTensorData(t, tensor, []float32{0, 1, 2, 3, 4, 5}, shape)
+	})
+
+	t.Run("ZeroSizedDimension", func(t *testing.T) {
+		tensor, err := NewEmptyTensor[int64](runtime, []int64{0, 3})
+		if err != nil {
+			t.Fatalf("Failed to create empty tensor: %v", err)
+		}
+		defer tensor.Close()
+
+		data, err := MutableData[int64](tensor)
+		if err != nil {
+			t.Fatalf("Failed to get mutable data: %v", err)
+		}
+		if len(data) != 0 {
+			t.Errorf("Expected no elements, got %d", len(data))
+		}
+	})
+
+	t.Run("NegativeDimension", func(t *testing.T) {
+		_, err := NewEmptyTensor[float32](runtime, []int64{-1, 3})
+		if err == nil {
+			t.Error("Expected error when creating tensor with negative dimension")
+		}
+	})
+}